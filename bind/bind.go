@@ -0,0 +1,51 @@
+// Package bind generates Go mirrors of the datatypes of Move packages, decodable with mystenbcs.
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// NormalizedModulesReader is the part of the Sui client used by FetchModules, sui.ISuiAPI implements it.
+type NormalizedModulesReader interface {
+	SuiGetNormalizedMoveModulesByPackage(ctx context.Context, req models.GetNormalizedMoveModulesByPackageRequest) (models.GetNormalizedMoveModulesByPackageResponse, error)
+}
+
+// FetchModules fetches the normalized modules of a package.
+func FetchModules(ctx context.Context, client NormalizedModulesReader, packageId string) (models.GetNormalizedMoveModulesByPackageResponse, error) {
+	modules, err := client.SuiGetNormalizedMoveModulesByPackage(ctx, models.GetNormalizedMoveModulesByPackageRequest{
+		Package: packageId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the modules of %s: %v", packageId, err)
+	}
+	return modules, nil
+}
+
+// LoadModules reads the normalized modules of a package from a JSON file, either the result of
+// `suix_getNormalizedMoveModulesByPackage` or the whole JSON-RPC response.
+func LoadModules(path string) (models.GetNormalizedMoveModulesByPackageResponse, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var envelope struct {
+		Jsonrpc string          `json:"jsonrpc"`
+		Result  json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid modules file %s: %v", path, err)
+	}
+	if envelope.Jsonrpc != "" {
+		data = envelope.Result
+	}
+	var modules models.GetNormalizedMoveModulesByPackageResponse
+	if err := json.Unmarshal(data, &modules); err != nil {
+		return nil, fmt.Errorf("invalid modules file %s: %v", path, err)
+	}
+	return modules, nil
+}
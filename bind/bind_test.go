@@ -0,0 +1,336 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/movebcs"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/transaction"
+)
+
+// modulesJSON is a `suix_getNormalizedMoveModulesByPackage` result.
+const modulesJSON = `{
+  "pool": {
+    "fileFormatVersion": 6, "address": "0xabc", "name": "pool", "friends": [],
+    "exposedFunctions": {
+      "swap": {
+        "visibility": "Public", "isEntry": false,
+        "typeParameters": [{"abilities": []}],
+        "parameters": [
+          {"MutableReference": {"Struct": {"address": "0xabc", "module": "pool", "name": "Pool", "typeArguments": [{"TypeParameter": 0}, "U64"]}}},
+          {"Struct": {"address": "0x2", "module": "coin", "name": "Coin", "typeArguments": [{"TypeParameter": 0}]}},
+          "U64",
+          {"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": ["U64"]}},
+          {"Vector": "Address"},
+          {"Struct": {"address": "0x1", "module": "string", "name": "String", "typeArguments": []}},
+          {"MutableReference": {"Struct": {"address": "0x2", "module": "tx_context", "name": "TxContext", "typeArguments": []}}}
+        ],
+        "return": [{"Struct": {"address": "0x2", "module": "coin", "name": "Coin", "typeArguments": [{"TypeParameter": 0}]}}]
+      },
+      "price": {
+        "visibility": "Public", "isEntry": false,
+        "typeParameters": [],
+        "parameters": [{"Reference": {"Struct": {"address": "0xabc", "module": "pool", "name": "Config", "typeArguments": []}}}],
+        "return": ["U64", {"Struct": {"address": "0x1", "module": "string", "name": "String", "typeArguments": []}}]
+      },
+      "value": {
+        "visibility": "Private", "isEntry": true,
+        "typeParameters": [{"abilities": ["Copy", "Drop"]}],
+        "parameters": [{"TypeParameter": 0}],
+        "return": [{"TypeParameter": 0}]
+      },
+      "borrow": {
+        "visibility": "Public", "isEntry": false,
+        "typeParameters": [],
+        "parameters": [{"Reference": {"Struct": {"address": "0xabc", "module": "pool", "name": "Config", "typeArguments": []}}}],
+        "return": [{"Reference": "U64"}]
+      },
+      "reset": {
+        "visibility": "Friend", "isEntry": false,
+        "typeParameters": [],
+        "parameters": [],
+        "return": []
+      }
+    },
+    "structs": {
+      "Pool": {
+        "abilities": {"abilities": ["Key"]},
+        "typeParameters": [{"constraints": {"abilities": []}, "isPhantom": true}, {"constraints": {"abilities": ["Copy"]}, "isPhantom": false}],
+        "fields": [
+          {"name": "id", "type": {"Struct": {"address": "0x2", "module": "object", "name": "UID", "typeArguments": []}}},
+          {"name": "balance", "type": {"Struct": {"address": "0x2", "module": "balance", "name": "Balance", "typeArguments": [{"TypeParameter": 0}]}}},
+          {"name": "total_shares", "type": "U128"},
+          {"name": "limit", "type": {"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": ["U64"]}}},
+          {"name": "name", "type": {"Struct": {"address": "0x1", "module": "string", "name": "String", "typeArguments": []}}},
+          {"name": "data", "type": {"Vector": "U8"}},
+          {"name": "weights", "type": {"Struct": {"address": "0x2", "module": "vec_map", "name": "VecMap", "typeArguments": ["Address", {"TypeParameter": 1}]}}},
+          {"name": "history", "type": {"Vector": {"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": ["U64"]}}}},
+          {"name": "status", "type": {"Struct": {"address": "0xabc", "module": "pool", "name": "Status", "typeArguments": []}}},
+          {"name": "config", "type": {"Struct": {"address": "0xabc", "module": "pool", "name": "Config", "typeArguments": []}}}
+        ]
+      },
+      "PoolCreated": {
+        "abilities": {"abilities": ["Copy", "Drop"]},
+        "typeParameters": [],
+        "fields": [
+          {"name": "pool_id", "type": {"Struct": {"address": "0x2", "module": "object", "name": "ID", "typeArguments": []}}},
+          {"name": "amount", "type": "U256"}
+        ]
+      },
+      "Config": {
+        "abilities": {"abilities": ["Store"]},
+        "typeParameters": [],
+        "fields": [{"name": "fee", "type": "U64"}]
+      }
+    },
+    "enums": {
+      "Status": {
+        "abilities": {"abilities": ["Copy", "Drop", "Store"]},
+        "typeParameters": [],
+        "variants": {
+          "Closed": [{"name": "at", "type": "U64"}],
+          "Open": []
+        },
+        "variantDeclarationOrder": ["Open", "Closed"]
+      }
+    }
+  },
+  "market": {
+    "fileFormatVersion": 6, "address": "0xabc", "name": "market", "friends": [], "exposedFunctions": {},
+    "structs": {
+      "Config": {
+        "abilities": {"abilities": ["Store"]},
+        "typeParameters": [],
+        "fields": [{"name": "owner", "type": "Address"}]
+      }
+    }
+  }
+}`
+
+func loadTestModules(t *testing.T) models.GetNormalizedMoveModulesByPackageResponse {
+	var modules models.GetNormalizedMoveModulesByPackageResponse
+	require.NoError(t, json.Unmarshal([]byte(modulesJSON), &modules))
+	return modules
+}
+
+func TestGenerate(t *testing.T) {
+	source, err := Generate(loadTestModules(t), &Config{PackageName: "pool"})
+	require.NoError(t, err)
+
+	// the generated package must compile against the packages it imports
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "pool.go", source, parser.AllErrors)
+	require.NoError(t, err, string(source))
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = config.Check("pool", fset, []*ast.File{file}, nil)
+	require.NoError(t, err, string(source))
+
+	// the alignment of gofmt is ignored
+	generated := regexp.MustCompile(`[ \t]+`).ReplaceAllString(string(source), " ")
+	for _, expected := range []string{
+		`PoolType = "0x0000000000000000000000000000000000000000000000000000000000000abc::pool::Pool"`,
+		"var EventTypes = []string{\n PoolCreatedType,\n StatusType,\n}",
+		"type Pool[T1 any] struct {\n Id models.SuiAddressBytes\n Balance uint64\n TotalShares mystenbcs.U128\n" +
+			" Limit *uint64 `bcs:\"optional\"`\n Name string\n Data []byte\n Weights bind.VecMap[models.SuiAddressBytes, T1]\n" +
+			" History [][]uint64\n Status Status\n Config PoolConfig\n}",
+		"type PoolCreated struct {\n PoolId models.SuiAddressBytes\n Amount mystenbcs.U256\n}",
+		"type MarketConfig struct {",
+		"type Status struct {\n Open *StatusOpen\n Closed *StatusClosed\n}",
+		"func (Status) IsBcsEnum() {}",
+		"type StatusClosed struct {\n At uint64\n}",
+		`const PackageID models.SuiAddress = "0x0000000000000000000000000000000000000000000000000000000000000abc"`,
+		"func Swap(tx *transaction.Transaction, typeArg0 transaction.TypeTag, arg0 bind.ObjectArg, arg1 bind.ObjectArg, arg2 uint64, arg3 *uint64, arg4 []models.SuiAddressBytes, arg5 string) transaction.Argument {\n" +
+			" return tx.MoveCall(PackageID, \"pool\", \"swap\", []transaction.TypeTag{typeArg0}, []transaction.Argument{\n" +
+			" tx.Object(arg0),\n tx.Object(arg1),\n bind.Pure(tx, arg2),\n bind.Pure(tx, bind.Option(arg3)),\n bind.Pure(tx, arg4),\n bind.Pure(tx, arg5),\n })\n}",
+		"func InspectSwap(ctx context.Context, client bind.DevInspector, sender models.SuiAddress, typeArg0 transaction.TypeTag,",
+		") (r0 bind.Coin, err error) {",
+		"func InspectPrice(ctx context.Context, client bind.DevInspector, sender models.SuiAddress, arg0 bind.ObjectArg) (r0 uint64, r1 string, err error) {",
+		"func InspectValue[T0 any](ctx context.Context, client bind.DevInspector, sender models.SuiAddress, typeArg0 transaction.TypeTag, arg0 bind.ObjectArg) (r0 T0, err error) {",
+	} {
+		require.Contains(t, generated, expected)
+	}
+
+	// functions returning references and friend functions can't be called
+	require.NotContains(t, generated, "func Borrow(")
+	require.NotContains(t, generated, "func Reset(")
+
+	// the output is deterministic
+	again, err := Generate(loadTestModules(t), &Config{PackageName: "pool"})
+	require.NoError(t, err)
+	require.Equal(t, source, again)
+}
+
+func TestGenerateFieldNameCollision(t *testing.T) {
+	for _, names := range [][2]string{{"foo_bar", "fooBar"}, {"a_b", "a__b"}} {
+		modules := loadTestModules(t)
+		modules["market"].Structs["Order"] = map[string]interface{}{
+			"abilities":      map[string]interface{}{"abilities": []string{"Store"}},
+			"typeParameters": []interface{}{},
+			"fields": []interface{}{
+				map[string]interface{}{"name": names[0], "type": "U64"},
+				map[string]interface{}{"name": names[1], "type": "U64"},
+			},
+		}
+
+		_, err := Generate(modules, &Config{PackageName: "pool"})
+		require.ErrorContains(t, err, "fields "+names[0]+" and "+names[1]+" of market::Order")
+	}
+}
+
+func TestGenerateExternalTypes(t *testing.T) {
+	modules := loadTestModules(t)
+	market := modules["market"]
+	market.Structs["Order"] = map[string]interface{}{
+		"abilities":      map[string]interface{}{"abilities": []string{"Store"}},
+		"typeParameters": []interface{}{},
+		"fields": []interface{}{
+			map[string]interface{}{"name": "price", "type": map[string]interface{}{"Struct": map[string]interface{}{"address": "0xdef", "module": "math", "name": "Decimal", "typeArguments": []interface{}{}}}},
+		},
+	}
+
+	_, err := Generate(modules, &Config{PackageName: "pool"})
+	require.ErrorContains(t, err, "type mappings")
+
+	source, err := Generate(modules, &Config{
+		PackageName:  "pool",
+		TypeMappings: map[string]TypeMapping{"0xdef::math::Decimal": {Type: "math.Decimal", Import: "example.com/math"}},
+	})
+	require.NoError(t, err)
+	require.Contains(t, string(source), `"example.com/math"`)
+	require.Regexp(t, `Price\s+math\.Decimal`, string(source))
+}
+
+// generatedPool is the Go type generated for 0xabc::pool::Pool.
+type generatedPool[T1 any] struct {
+	Id          models.SuiAddressBytes
+	Balance     uint64
+	TotalShares mystenbcs.U128
+	Limit       *uint64 `bcs:"optional"`
+	Name        string
+	Data        []byte
+	Weights     VecMap[models.SuiAddressBytes, T1]
+	History     [][]uint64
+	Status      generatedStatus
+	Config      struct{ Fee uint64 }
+}
+
+type generatedStatus struct {
+	Open   *struct{}
+	Closed *struct{ At uint64 }
+}
+
+func (generatedStatus) IsBcsEnum() {}
+
+func TestGeneratedTypeRoundTrip(t *testing.T) {
+	limit := uint64(7)
+	pool := generatedPool[bool]{
+		Id:          models.SuiAddressBytes{0xab},
+		Balance:     1000,
+		TotalShares: mystenbcs.U128{Int: new(big.Int).Lsh(big.NewInt(1), 100)},
+		Limit:       &limit,
+		Name:        "pool",
+		Data:        []byte{1, 2, 3},
+		Weights:     VecMap[models.SuiAddressBytes, bool]{Contents: []VecMapEntry[models.SuiAddressBytes, bool]{{Key: models.SuiAddressBytes{0x01}, Value: true}}},
+		History:     [][]uint64{{}, {5}},
+		Status:      generatedStatus{Closed: &struct{ At uint64 }{At: 42}},
+		Config:      struct{ Fee uint64 }{Fee: 30},
+	}
+	data, err := mystenbcs.Marshal(&pool)
+	require.NoError(t, err)
+
+	var decoded generatedPool[bool]
+	n, err := mystenbcs.Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, 0, pool.TotalShares.Cmp(decoded.TotalShares.Int))
+	decoded.TotalShares = pool.TotalShares
+	require.Equal(t, pool, decoded)
+}
+
+func TestParseTypeTag(t *testing.T) {
+	marker := true
+	typeTag, err := ParseTypeTag("vector<0x2::coin::Coin<u64>>")
+	require.NoError(t, err)
+	require.Equal(t, transaction.TypeTag{Vector: &transaction.TypeTag{Struct: &transaction.StructTag{
+		Address:    models.SuiAddressBytes{31: 2},
+		Module:     "coin",
+		Name:       "Coin",
+		TypeParams: []*transaction.TypeTag{{U64: &marker}},
+	}}}, typeTag)
+
+	_, err = ParseTypeTag("0x2::coin")
+	require.Error(t, err)
+	require.Panics(t, func() { MustParseTypeTag("vector<") })
+}
+
+func TestPure(t *testing.T) {
+	tx := transaction.NewTransaction()
+	limit := uint64(5)
+	arguments := []transaction.Argument{
+		Pure(tx, "0x2"),
+		Pure(tx, Option(&limit)),
+		Pure(tx, Option[uint64](nil)),
+	}
+	inputs := tx.Data.V1.Kind.ProgrammableTransaction.Inputs
+	require.Len(t, inputs, len(arguments))
+	// strings are Move strings, even when they look like addresses
+	require.Equal(t, []byte{3, '0', 'x', '2'}, inputs[0].Pure.Bytes)
+	require.Equal(t, []byte{1, 5, 0, 0, 0, 0, 0, 0, 0}, inputs[1].Pure.Bytes)
+	require.Equal(t, []byte{0}, inputs[2].Pure.Bytes)
+}
+
+type fakeDevInspector struct {
+	request  models.SuiDevInspectTransactionBlockRequest
+	response string
+}
+
+func (f *fakeDevInspector) SuiDevInspectTransactionBlock(_ context.Context, req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	f.request = req
+	var rsp models.SuiTransactionBlockResponse
+	err := json.Unmarshal([]byte(f.response), &rsp)
+	return rsp, err
+}
+
+func TestDevInspect(t *testing.T) {
+	client := &fakeDevInspector{response: `{
+		"effects": {"status": {"status": "success"}},
+		"results": [
+			{"returnValues": [[[1], "bool"]]},
+			{"returnValues": [[[42, 0, 0, 0, 0, 0, 0, 0], "u64"], [[3, 83, 117, 105], "0x1::string::String"]]}
+		]
+	}`}
+	tx := transaction.NewTransaction()
+	tx.MoveCall("0xabc", "pool", "price", nil, []transaction.Argument{Pure(tx, uint64(1))})
+
+	values, err := DevInspect(context.Background(), client, tx, "0x1")
+	require.NoError(t, err)
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", client.request.Sender)
+	require.NotEmpty(t, client.request.TxBytes)
+	require.Len(t, values, 2)
+
+	var price uint64
+	require.NoError(t, DecodeReturnValue(values, 0, &price))
+	require.Equal(t, uint64(42), price)
+	var name string
+	require.NoError(t, DecodeReturnValue(values, 1, &name))
+	require.Equal(t, "Sui", name)
+	require.Error(t, DecodeReturnValue(values, 2, &name))
+	var flag bool
+	require.ErrorIs(t, DecodeReturnValue(values, 0, &flag), movebcs.ErrTrailingBytes)
+
+	client.response = `{"effects": {"status": {"status": "failure", "error": "MoveAbort(1)"}}}`
+	_, err = DevInspect(context.Background(), client, tx, "0x1")
+	require.ErrorContains(t, err, "MoveAbort(1)")
+}
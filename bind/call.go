@@ -0,0 +1,157 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/movebcs"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/block-vision/sui-go-sdk/utils"
+)
+
+// ObjectArg is an object argument of a generated call, anything accepted by Transaction.Object:
+// an object id, a transaction.CallArg or the transaction.Argument of another command.
+type ObjectArg = any
+
+// DevInspector is the part of the Sui client used by the generated view functions, sui.ISuiAPI implements it.
+type DevInspector interface {
+	SuiDevInspectTransactionBlock(ctx context.Context, req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error)
+}
+
+// Pure adds the BCS encoding of the value as a pure input. Unlike Transaction.Pure strings are
+// always encoded as Move strings, addresses must be models.SuiAddressBytes.
+func Pure(tx *transaction.Transaction, value any) transaction.Argument {
+	data, err := mystenbcs.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return tx.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: data}})
+}
+
+// Option converts an optional value to the encoding of a Move Option, a vector of at most one element.
+func Option[T any](value *T) []T {
+	if value == nil {
+		return []T{}
+	}
+	return []T{*value}
+}
+
+// ParseTypeTag parses a Move type such as `0x2::sui::SUI` as a type argument of a call.
+func ParseTypeTag(value string) (transaction.TypeTag, error) {
+	typeTag, err := movebcs.ParseTypeTag(value)
+	if err != nil {
+		return transaction.TypeTag{}, err
+	}
+	return convertTypeTag(typeTag)
+}
+
+// MustParseTypeTag is like ParseTypeTag but panics if the type is invalid.
+func MustParseTypeTag(value string) transaction.TypeTag {
+	typeTag, err := ParseTypeTag(value)
+	if err != nil {
+		panic(err)
+	}
+	return typeTag
+}
+
+func convertTypeTag(typeTag *movebcs.TypeTag) (transaction.TypeTag, error) {
+	marker := true
+	switch typeTag.Kind {
+	case movebcs.BoolType:
+		return transaction.TypeTag{Bool: &marker}, nil
+	case movebcs.U8Type:
+		return transaction.TypeTag{U8: &marker}, nil
+	case movebcs.U16Type:
+		return transaction.TypeTag{U16: &marker}, nil
+	case movebcs.U32Type:
+		return transaction.TypeTag{U32: &marker}, nil
+	case movebcs.U64Type:
+		return transaction.TypeTag{U64: &marker}, nil
+	case movebcs.U128Type:
+		return transaction.TypeTag{U128: &marker}, nil
+	case movebcs.U256Type:
+		return transaction.TypeTag{U256: &marker}, nil
+	case movebcs.AddressType:
+		return transaction.TypeTag{Address: &marker}, nil
+	case movebcs.SignerType:
+		return transaction.TypeTag{Signer: &marker}, nil
+	case movebcs.VectorType:
+		elem, err := convertTypeTag(typeTag.Elem)
+		if err != nil {
+			return transaction.TypeTag{}, err
+		}
+		return transaction.TypeTag{Vector: &elem}, nil
+	case movebcs.StructType:
+		address, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(typeTag.Struct.Address))
+		if err != nil {
+			return transaction.TypeTag{}, err
+		}
+		structTag := &transaction.StructTag{Address: *address, Module: typeTag.Struct.Module, Name: typeTag.Struct.Name}
+		for _, param := range typeTag.Struct.TypeParams {
+			converted, err := convertTypeTag(param)
+			if err != nil {
+				return transaction.TypeTag{}, err
+			}
+			structTag.TypeParams = append(structTag.TypeParams, &converted)
+		}
+		return transaction.TypeTag{Struct: structTag}, nil
+	default:
+		return transaction.TypeTag{}, fmt.Errorf("%s is not a type argument", typeTag)
+	}
+}
+
+// DevInspect runs the transaction in dev-inspect mode and returns the BCS return values of its last command.
+func DevInspect(ctx context.Context, client DevInspector, tx *transaction.Transaction, sender models.SuiAddress) ([][]byte, error) {
+	kind, err := tx.Data.V1.Kind.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := client.SuiDevInspectTransactionBlock(ctx, models.SuiDevInspectTransactionBlockRequest{
+		Sender:  string(utils.NormalizeSuiAddress(string(sender))),
+		TxBytes: mystenbcs.ToBase64(kind),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.Effects.Status.Status == "failure" {
+		return nil, fmt.Errorf("dev inspect failed: %s", rsp.Effects.Status.Error)
+	}
+
+	// the return values are pairs of the bytes and the Move type
+	var results []struct {
+		ReturnValues [][2]json.RawMessage `json:"returnValues"`
+	}
+	if err := json.Unmarshal(rsp.Results, &results); err != nil {
+		return nil, fmt.Errorf("invalid dev inspect results: %v", err)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("dev inspect returned no results")
+	}
+	returnValues := results[len(results)-1].ReturnValues
+	values := make([][]byte, len(returnValues))
+	for i, returnValue := range returnValues {
+		if err := json.Unmarshal(returnValue[0], &values[i]); err != nil {
+			return nil, fmt.Errorf("invalid return value %d: %v", i, err)
+		}
+	}
+	return values, nil
+}
+
+// DecodeReturnValue decodes the BCS return value at the index, all its bytes must be consumed.
+func DecodeReturnValue(values [][]byte, index int, target any) error {
+	if index >= len(values) {
+		return fmt.Errorf("missing return value %d, got %d values", index, len(values))
+	}
+	n, err := mystenbcs.Unmarshal(values[index], target)
+	if err != nil {
+		return fmt.Errorf("failed to decode return value %d: %v", index, err)
+	}
+	if n != len(values[index]) {
+		return fmt.Errorf("failed to decode return value %d: %w", index, movebcs.ErrTrailingBytes)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/movebcs"
+)
+
+const transactionImport = "github.com/block-vision/sui-go-sdk/transaction"
+
+// normalizedFunction is a `SuiMoveNormalizedFunction`.
+type normalizedFunction struct {
+	Visibility     string            `json:"visibility"`
+	IsEntry        bool              `json:"isEntry"`
+	TypeParameters []json.RawMessage `json:"typeParameters"`
+	Parameters     []json.RawMessage `json:"parameters"`
+	Return         []json.RawMessage `json:"return"`
+}
+
+type parameter struct {
+	moveType  *movebcs.TypeTag
+	reference bool
+}
+
+type function struct {
+	module     string
+	name       string
+	goName     string
+	typeParams int
+	params     []parameter
+	returns    []parameter
+}
+
+// loadFunction registers the public and entry functions callable in a programmable transaction.
+func (g *generator) loadFunction(module, name string, normalized interface{}) error {
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return err
+	}
+	var f normalizedFunction
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("invalid normalized function %s::%s: %v", module, name, err)
+	}
+	if f.Visibility != "Public" && !f.IsEntry {
+		return nil
+	}
+
+	registered := &function{module: module, name: name, typeParams: len(f.TypeParameters)}
+	for i, normalizedParam := range f.Parameters {
+		param, err := parseParameter(normalizedParam)
+		if err != nil {
+			return fmt.Errorf("invalid type of parameter %d of %s::%s: %v", i, module, name, err)
+		}
+		// the TxContext is passed by the runtime
+		if param.moveType.Kind == movebcs.StructType && param.moveType.Struct.Is("0x2", "tx_context", "TxContext") {
+			continue
+		}
+		registered.params = append(registered.params, param)
+	}
+	for i, normalizedReturn := range f.Return {
+		ret, err := parseParameter(normalizedReturn)
+		if err != nil {
+			return fmt.Errorf("invalid return type %d of %s::%s: %v", i, module, name, err)
+		}
+		// references can't be returned to a programmable transaction
+		if ret.reference {
+			return nil
+		}
+		registered.returns = append(registered.returns, ret)
+	}
+	g.functions[module+"::"+name] = registered
+	return nil
+}
+
+// parseParameter parses a normalized type which may be a reference, `{"Reference": ...}` or
+// `{"MutableReference": ...}`.
+func parseParameter(data json.RawMessage) (parameter, error) {
+	var reference struct {
+		Reference        json.RawMessage `json:"Reference"`
+		MutableReference json.RawMessage `json:"MutableReference"`
+	}
+	if err := json.Unmarshal(data, &reference); err == nil {
+		referenced := reference.Reference
+		if referenced == nil {
+			referenced = reference.MutableReference
+		}
+		if referenced != nil {
+			moveType, err := movebcs.ParseNormalizedType(referenced)
+			return parameter{moveType: moveType, reference: true}, err
+		}
+	}
+	moveType, err := movebcs.ParseNormalizedType(data)
+	return parameter{moveType: moveType}, err
+}
+
+func (g *generator) sortedFunctionKeys() []string {
+	keys := make([]string, 0, len(g.functions))
+	for key := range g.functions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeFunction writes the function appending the MoveCall of the Move function to a transaction:
+//   - its type arguments are transaction.TypeTag, see ParseTypeTag
+//   - its pure arguments, primitives, strings, IDs, Options and vectors of them, are their Go
+//     types, Option arguments are pointers
+//   - its other arguments, references and objects, are ObjectArg
+//   - the TxContext argument is omitted
+//
+// The dev-inspect function takes the same arguments and decodes the return values.
+func (g *generator) writeFunction(w *bytes.Buffer, f *function) error {
+	g.imports[transactionImport] = true
+	moveFunction := f.module + "::" + f.name
+
+	params := []string{"tx *transaction.Transaction"}
+	var names, typeArgs, args []string
+	for i := 0; i < f.typeParams; i++ {
+		name := fmt.Sprintf("typeArg%d", i)
+		params = append(params, name+" transaction.TypeTag")
+		names = append(names, name)
+		typeArgs = append(typeArgs, name)
+	}
+	for i, param := range f.params {
+		name := fmt.Sprintf("arg%d", i)
+		goType, arg, err := g.argument(param, name)
+		if err != nil {
+			return fmt.Errorf("parameter %d: %v", i, err)
+		}
+		params = append(params, name+" "+goType)
+		names = append(names, name)
+		args = append(args, arg)
+	}
+
+	typeArgsList := "nil"
+	if len(typeArgs) > 0 {
+		typeArgsList = "[]transaction.TypeTag{" + strings.Join(typeArgs, ", ") + "}"
+	}
+	argsList := "nil"
+	if len(args) > 0 {
+		argsList = "[]transaction.Argument{\n" + strings.Join(args, ",\n") + ",\n}"
+	}
+	fmt.Fprintf(w, "// %s appends a call of %s to the transaction.\n", f.goName, moveFunction)
+	fmt.Fprintf(w, "func %s(%s) transaction.Argument {\n", f.goName, strings.Join(params, ", "))
+	fmt.Fprintf(w, "return tx.MoveCall(PackageID, %q, %q, %s, %s)\n}\n\n", f.module, f.name, typeArgsList, argsList)
+
+	if len(f.returns) == 0 {
+		return nil
+	}
+	returnTypes, ok := g.returnTypes(f)
+	if !ok {
+		return nil
+	}
+	g.imports["context"] = true
+	g.imports[bindImport] = true
+	g.imports[modelsImport] = true
+
+	var results []string
+	for i, returnType := range returnTypes {
+		results = append(results, fmt.Sprintf("r%d %s", i, returnType))
+	}
+	results = append(results, "err error")
+	fmt.Fprintf(w, "// Inspect%s calls %s in dev-inspect mode and decodes its return values.\n", f.goName, moveFunction)
+	inspectParams := append([]string{"ctx context.Context", "client bind.DevInspector", "sender models.SuiAddress"}, params[1:]...)
+	fmt.Fprintf(w, "func Inspect%s%s(%s) (%s) {\n",
+		f.goName, returnTypeParams(returnTypes), strings.Join(inspectParams, ", "), strings.Join(results, ", "))
+	w.WriteString("tx := transaction.NewTransaction()\n")
+	fmt.Fprintf(w, "%s(%s)\n", f.goName, strings.Join(append([]string{"tx"}, names...), ", "))
+	w.WriteString("values, err := bind.DevInspect(ctx, client, tx, sender)\nif err != nil {\nreturn\n}\n")
+	for i := range returnTypes {
+		if i == len(returnTypes)-1 {
+			fmt.Fprintf(w, "err = bind.DecodeReturnValue(values, %d, &r%d)\nreturn\n}\n\n", i, i)
+		} else {
+			fmt.Fprintf(w, "if err = bind.DecodeReturnValue(values, %d, &r%d); err != nil {\nreturn\n}\n", i, i)
+		}
+	}
+	return nil
+}
+
+// argument returns the Go type of the parameter and the expression of its transaction argument.
+func (g *generator) argument(param parameter, name string) (string, string, error) {
+	g.imports[bindImport] = true
+	if param.reference || !isPure(param.moveType) {
+		return "bind.ObjectArg", "tx.Object(" + name + ")", nil
+	}
+	if param.moveType.Kind == movebcs.StructType && param.moveType.Struct.Is("0x1", "option", "Option") {
+		elem, err := g.goType(param.moveType.Struct.TypeParams[0])
+		if err != nil {
+			return "", "", err
+		}
+		return "*" + elem, "bind.Pure(tx, bind.Option(" + name + "))", nil
+	}
+	goType, err := g.goType(param.moveType)
+	if err != nil {
+		return "", "", err
+	}
+	return goType, "bind.Pure(tx, " + name + ")", nil
+}
+
+// returnTypes returns the Go types of the return values, false when one of them has none. The
+// imports are only kept when all of them have one.
+func (g *generator) returnTypes(f *function) ([]string, bool) {
+	imports := make(map[string]bool, len(g.imports))
+	for path := range g.imports {
+		imports[path] = true
+	}
+	var returnTypes []string
+	for _, ret := range f.returns {
+		returnType, err := g.goType(ret.moveType)
+		if err != nil {
+			g.imports = imports
+			return nil, false
+		}
+		returnTypes = append(returnTypes, returnType)
+	}
+	return returnTypes, true
+}
+
+// typeParamPattern matches the Go type parameters of the Move type parameters.
+var typeParamPattern = regexp.MustCompile(`\bT(\d+)\b`)
+
+// returnTypeParams declares the Go type parameters used by the Go return types, the type
+// arguments of the dev-inspect function can't be inferred so they must be given by the caller.
+func returnTypeParams(returnTypes []string) string {
+	used := make(map[int]bool)
+	var indexes []int
+	for _, returnType := range returnTypes {
+		for _, match := range typeParamPattern.FindAllStringSubmatch(returnType, -1) {
+			index, _ := strconv.Atoi(match[1])
+			if !used[index] {
+				used[index] = true
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return ""
+	}
+	sort.Ints(indexes)
+	params := make([]string, len(indexes))
+	for i, index := range indexes {
+		params[i] = fmt.Sprintf("T%d any", index)
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// isPure reports whether values of the type are pure arguments of a programmable transaction.
+func isPure(moveType *movebcs.TypeTag) bool {
+	switch moveType.Kind {
+	case movebcs.TypeParameterType, movebcs.SignerType:
+		return false
+	case movebcs.VectorType:
+		return isPure(moveType.Elem)
+	case movebcs.StructType:
+		s := moveType.Struct
+		switch {
+		case s.Is("0x1", "string", "String"), s.Is("0x1", "ascii", "String"), s.Is("0x2", "object", "ID"):
+			return true
+		case s.Is("0x1", "option", "Option"):
+			return len(s.TypeParams) == 1 && isPure(s.TypeParams[0])
+		default:
+			return false
+		}
+	default:
+		return true
+	}
+}
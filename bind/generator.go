@@ -0,0 +1,613 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/movebcs"
+	"github.com/block-vision/sui-go-sdk/utils"
+)
+
+const (
+	modelsImport    = "github.com/block-vision/sui-go-sdk/models"
+	mystenbcsImport = "github.com/block-vision/sui-go-sdk/mystenbcs"
+	bindImport      = "github.com/block-vision/sui-go-sdk/bind"
+)
+
+// Config configures the generated code.
+type Config struct {
+	// PackageName is the name of the generated Go package.
+	PackageName string
+	// PackageID is the package called by the generated functions, by default the address of the
+	// modules. The modules of an upgraded package have the address of its first version, so it
+	// must be set to the id of the version to call.
+	PackageID string
+	// TypeMappings maps Move datatypes of other packages, e.g. `0xabc::pool::Pool`, to Go types.
+	TypeMappings map[string]TypeMapping
+}
+
+// TypeMapping is the Go type of a Move datatype, the type arguments of the Move type are ignored.
+type TypeMapping struct {
+	// Type is the Go type, e.g. `pool.Pool`.
+	Type string
+	// Import is the import path of the package of the type, empty for a type of the generated package.
+	Import string
+}
+
+// frameworkType is the Go type of a Move framework datatype.
+type frameworkType struct {
+	goType     string
+	importPath string
+	// typeArgs are the indexes of the Move type arguments passed as Go type arguments.
+	typeArgs []int
+}
+
+var frameworkTypes = map[string]frameworkType{
+	"0x1::string::String":            {goType: "string"},
+	"0x1::ascii::String":             {goType: "string"},
+	"0x1::type_name::TypeName":       {goType: "string"},
+	"0x2::object::UID":               {goType: "models.SuiAddressBytes", importPath: modelsImport},
+	"0x2::object::ID":                {goType: "models.SuiAddressBytes", importPath: modelsImport},
+	"0x2::balance::Balance":          {goType: "uint64"},
+	"0x2::balance::Supply":           {goType: "uint64"},
+	"0x2::url::Url":                  {goType: "string"},
+	"0x2::coin::Coin":                {goType: "bind.Coin", importPath: bindImport},
+	"0x2::table::Table":              {goType: "bind.Table", importPath: bindImport},
+	"0x2::object_table::ObjectTable": {goType: "bind.Table", importPath: bindImport},
+	"0x2::bag::Bag":                  {goType: "bind.Table", importPath: bindImport},
+	"0x2::object_bag::ObjectBag":     {goType: "bind.Table", importPath: bindImport},
+	"0x2::table_vec::TableVec":       {goType: "bind.Table", importPath: bindImport},
+	"0x2::linked_table::LinkedTable": {goType: "bind.LinkedTable", importPath: bindImport, typeArgs: []int{0}},
+	"0x2::vec_map::VecMap":           {goType: "bind.VecMap", importPath: bindImport, typeArgs: []int{0, 1}},
+	"0x2::vec_set::VecSet":           {goType: "bind.VecSet", importPath: bindImport, typeArgs: []int{0}},
+}
+
+type normalizedField struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+// normalizedDatatype is a `SuiMoveNormalizedStruct` or a `SuiMoveNormalizedEnum`.
+type normalizedDatatype struct {
+	Abilities struct {
+		Abilities []string `json:"abilities"`
+	} `json:"abilities"`
+	TypeParameters []struct {
+		IsPhantom bool `json:"isPhantom"`
+	} `json:"typeParameters"`
+	Fields                  []normalizedField            `json:"fields"`
+	Variants                map[string][]normalizedField `json:"variants"`
+	VariantDeclarationOrder []string                     `json:"variantDeclarationOrder"`
+}
+
+type field struct {
+	name     string
+	goName   string
+	moveType *movebcs.TypeTag
+}
+
+type variant struct {
+	name   string
+	goName string
+	fields []field
+}
+
+type datatype struct {
+	module    string
+	name      string
+	goName    string
+	abilities []string
+	phantoms  []bool
+	isEnum    bool
+	fields    []field
+	variants  []variant
+}
+
+func (d *datatype) hasAbility(ability string) bool {
+	for _, a := range d.abilities {
+		if a == ability {
+			return true
+		}
+	}
+	return false
+}
+
+// typeParams returns the Go type parameters declaration, the non-phantom Move type parameters.
+func (d *datatype) typeParams() string {
+	var params []string
+	for i, phantom := range d.phantoms {
+		if !phantom {
+			params = append(params, fmt.Sprintf("T%d any", i))
+		}
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// typeArgs returns the Go type parameters as type arguments.
+func (d *datatype) typeArgs() string {
+	var args []string
+	for i, phantom := range d.phantoms {
+		if !phantom {
+			args = append(args, fmt.Sprintf("T%d", i))
+		}
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+type generator struct {
+	address      string
+	config       *Config
+	typeMappings map[string]TypeMapping
+	datatypes    map[string]*datatype
+	functions    map[string]*function
+	imports      map[string]bool
+}
+
+// Generate generates the Go source of the structs and enums of the normalized modules of a
+// package, as returned by `suix_getNormalizedMoveModulesByPackage`. The generated types are
+// decodable with mystenbcs:
+//   - u128 and u256 are mystenbcs.U128 and mystenbcs.U256, addresses, UID and ID are models.SuiAddressBytes
+//   - vector<u8> is []byte, String is string and Balance is uint64
+//   - Option fields are `bcs:"optional"` pointers, other Options are slices of at most one element
+//   - enums are mystenbcs enums, a struct of a pointer per variant
+//   - the non-phantom type parameters are Go type parameters, the phantom ones are dropped
+//
+// The Move type of every datatype is generated as a `<Name>Type` constant, and the datatypes with
+// copy and drop, which can be emitted as events, are listed in EventTypes.
+//
+// Every public or entry function gets a function appending its MoveCall to a transaction, see
+// writeFunction, and the functions whose return values are decodable get an `Inspect<Name>`
+// function calling it in dev-inspect mode. Friend and private functions are skipped, and so are
+// the functions returning references, which a programmable transaction can't call.
+func Generate(modules models.GetNormalizedMoveModulesByPackageResponse, config *Config) ([]byte, error) {
+	if config == nil || config.PackageName == "" {
+		return nil, fmt.Errorf("the Go package name is required")
+	}
+	g := &generator{
+		config:       config,
+		typeMappings: make(map[string]TypeMapping),
+		datatypes:    make(map[string]*datatype),
+		functions:    make(map[string]*function),
+		imports:      make(map[string]bool),
+	}
+	for moveType, mapping := range config.TypeMappings {
+		structTag, err := movebcs.ParseStructTag(moveType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type mapping %s: %v", moveType, err)
+		}
+		g.typeMappings[structTag.ID()] = mapping
+	}
+	if err := g.load(modules); err != nil {
+		return nil, err
+	}
+	if err := g.assignNames(); err != nil {
+		return nil, err
+	}
+	return g.generate()
+}
+
+func (g *generator) load(modules models.GetNormalizedMoveModulesByPackageResponse) error {
+	if len(modules) == 0 {
+		return fmt.Errorf("the package has no modules")
+	}
+	for moduleName, module := range modules {
+		address := string(utils.NormalizeSuiAddress(module.Address))
+		if g.address == "" {
+			g.address = address
+		} else if g.address != address {
+			return fmt.Errorf("modules of different packages %s and %s", g.address, address)
+		}
+
+		for name, normalized := range module.Structs {
+			d, registered, err := g.loadDatatype(moduleName, name, normalized)
+			if err != nil {
+				return err
+			}
+			for _, f := range d.Fields {
+				fieldType, err := movebcs.ParseNormalizedType(f.Type)
+				if err != nil {
+					return fmt.Errorf("invalid type of field %s of %s::%s: %v", f.Name, moduleName, name, err)
+				}
+				registered.fields = append(registered.fields, field{name: f.Name, moveType: fieldType})
+			}
+		}
+
+		for name, normalized := range module.Enums {
+			d, enum, err := g.loadDatatype(moduleName, name, normalized)
+			if err != nil {
+				return err
+			}
+			enum.isEnum = true
+			if len(d.VariantDeclarationOrder) != len(d.Variants) {
+				return fmt.Errorf("enum %s::%s has no variant declaration order", moduleName, name)
+			}
+			for _, variantName := range d.VariantDeclarationOrder {
+				normalizedFields, ok := d.Variants[variantName]
+				if !ok {
+					return fmt.Errorf("enum %s::%s has no variant %s", moduleName, name, variantName)
+				}
+				v := variant{name: variantName}
+				for _, f := range normalizedFields {
+					fieldType, err := movebcs.ParseNormalizedType(f.Type)
+					if err != nil {
+						return fmt.Errorf("invalid type of field %s of %s::%s::%s: %v", f.Name, moduleName, name, variantName, err)
+					}
+					v.fields = append(v.fields, field{name: f.Name, moveType: fieldType})
+				}
+				enum.variants = append(enum.variants, v)
+			}
+		}
+
+		for name, normalized := range module.ExposedFunctions {
+			if err := g.loadFunction(moduleName, name, normalized); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadDatatype remarshals the untyped normalized datatype and registers it.
+func (g *generator) loadDatatype(module, name string, normalized interface{}) (*normalizedDatatype, *datatype, error) {
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, nil, err
+	}
+	var d normalizedDatatype
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, nil, fmt.Errorf("invalid normalized datatype %s::%s: %v", module, name, err)
+	}
+	registered := &datatype{module: module, name: name, abilities: d.Abilities.Abilities}
+	for _, param := range d.TypeParameters {
+		registered.phantoms = append(registered.phantoms, param.IsPhantom)
+	}
+	g.datatypes[module+"::"+name] = registered
+	return &d, registered, nil
+}
+
+// assignNames names the Go types after the Move datatypes, prefixed by their module when the
+// name is used in several modules.
+func (g *generator) assignNames() error {
+	counts := make(map[string]int)
+	for _, d := range g.datatypes {
+		counts[toCamelCase(d.name)]++
+	}
+	for _, f := range g.functions {
+		counts[toCamelCase(f.name)]++
+	}
+
+	used := map[string]string{"PackageID": "the package id", "EventTypes": "the event types"}
+	use := func(goName, moveName string) error {
+		if other, ok := used[goName]; ok {
+			return fmt.Errorf("%s and %s are both named %s in Go", other, moveName, goName)
+		}
+		used[goName] = moveName
+		return nil
+	}
+	for _, key := range g.sortedKeys() {
+		d := g.datatypes[key]
+		d.goName = toCamelCase(d.name)
+		if counts[d.goName] > 1 {
+			d.goName = toCamelCase(d.module) + d.goName
+		}
+		if err := use(d.goName, key); err != nil {
+			return err
+		}
+		if err := use(d.goName+"Type", key+" type"); err != nil {
+			return err
+		}
+		if err := assignFieldNames(key, d.fields); err != nil {
+			return err
+		}
+		for i := range d.variants {
+			d.variants[i].goName = d.goName + toCamelCase(d.variants[i].name)
+			if err := use(d.variants[i].goName, key+"::"+d.variants[i].name); err != nil {
+				return err
+			}
+			if err := assignFieldNames(key+"::"+d.variants[i].name, d.variants[i].fields); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range g.sortedFunctionKeys() {
+		f := g.functions[key]
+		f.goName = toCamelCase(f.name)
+		if counts[f.goName] > 1 {
+			f.goName = toCamelCase(f.module) + f.goName
+		}
+		if err := use(f.goName, key); err != nil {
+			return err
+		}
+		if err := use("Inspect"+f.goName, key+" inspect"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignFieldNames names the Go fields of a struct or variant after its Move fields.
+func assignFieldNames(moveName string, fields []field) error {
+	used := make(map[string]string)
+	for i := range fields {
+		goName := toCamelCase(fields[i].name)
+		if other, ok := used[goName]; ok {
+			return fmt.Errorf("fields %s and %s of %s are both named %s in Go", other, fields[i].name, moveName, goName)
+		}
+		used[goName] = fields[i].name
+		fields[i].goName = goName
+	}
+	return nil
+}
+
+func (g *generator) sortedKeys() []string {
+	keys := make([]string, 0, len(g.datatypes))
+	for key := range g.datatypes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (g *generator) generate() ([]byte, error) {
+	var body bytes.Buffer
+	keys := g.sortedKeys()
+
+	if len(g.functions) > 0 {
+		packageId := g.config.PackageID
+		if packageId == "" {
+			packageId = g.address
+		}
+		g.imports[modelsImport] = true
+		fmt.Fprintf(&body, "// PackageID is the package called by the functions.\nconst PackageID models.SuiAddress = %q\n\n", utils.NormalizeSuiAddress(packageId))
+	}
+
+	if len(keys) > 0 {
+		body.WriteString("// Move types of the package, generic types are without their type arguments.\nconst (\n")
+		for _, key := range keys {
+			d := g.datatypes[key]
+			fmt.Fprintf(&body, "\t%sType = %q\n", d.goName, g.address+"::"+key)
+		}
+		body.WriteString(")\n\n")
+	}
+
+	var events []string
+	for _, key := range keys {
+		d := g.datatypes[key]
+		if d.hasAbility("Copy") && d.hasAbility("Drop") && !d.hasAbility("Key") {
+			events = append(events, d.goName+"Type")
+		}
+	}
+	if len(events) > 0 {
+		body.WriteString("// EventTypes are the Move types of the package with copy and drop, which can be emitted as events.\nvar EventTypes = []string{\n")
+		for _, event := range events {
+			fmt.Fprintf(&body, "\t%s,\n", event)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	for _, key := range keys {
+		d := g.datatypes[key]
+		moveType := g.address + "::" + key
+		if !d.isEnum {
+			fmt.Fprintf(&body, "// %s mirrors %s.\n", d.goName, moveType)
+			if err := g.writeStruct(&body, d, d.goName, d.fields); err != nil {
+				return nil, fmt.Errorf("%s: %v", moveType, err)
+			}
+			continue
+		}
+
+		fmt.Fprintf(&body, "// %s mirrors the enum %s, only the field of its variant is set.\n", d.goName, moveType)
+		fmt.Fprintf(&body, "type %s%s struct {\n", d.goName, d.typeParams())
+		for _, v := range d.variants {
+			fmt.Fprintf(&body, "\t%s *%s%s\n", toCamelCase(v.name), v.goName, d.typeArgs())
+		}
+		body.WriteString("}\n\n")
+		fmt.Fprintf(&body, "func (%s%s) IsBcsEnum() {}\n\n", d.goName, d.typeArgs())
+		for _, v := range d.variants {
+			fmt.Fprintf(&body, "// %s is the variant %s::%s.\n", v.goName, moveType, v.name)
+			if err := g.writeStruct(&body, d, v.goName, v.fields); err != nil {
+				return nil, fmt.Errorf("%s::%s: %v", moveType, v.name, err)
+			}
+		}
+	}
+
+	for _, key := range g.sortedFunctionKeys() {
+		if err := g.writeFunction(&body, g.functions[key]); err != nil {
+			return nil, fmt.Errorf("%s::%s: %v", g.address, key, err)
+		}
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "// Code generated by sui-go-bind. DO NOT EDIT.\n\npackage %s\n\n", g.config.PackageName)
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			imports = append(imports, path)
+		}
+		sort.Strings(imports)
+		source.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&source, "\t%q\n", path)
+		}
+		source.WriteString(")\n\n")
+	}
+	source.Write(body.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated code: %v", err)
+	}
+	return formatted, nil
+}
+
+func (g *generator) writeStruct(w *bytes.Buffer, d *datatype, goName string, fields []field) error {
+	if len(fields) == 0 {
+		fmt.Fprintf(w, "type %s%s struct{}\n\n", goName, d.typeParams())
+		return nil
+	}
+	fmt.Fprintf(w, "type %s%s struct {\n", goName, d.typeParams())
+	for _, f := range fields {
+		goType, optional, err := g.fieldType(f.moveType)
+		if err != nil {
+			return fmt.Errorf("field %s: %v", f.name, err)
+		}
+		if optional {
+			fmt.Fprintf(w, "\t%s %s `bcs:\"optional\"`\n", f.goName, goType)
+		} else {
+			fmt.Fprintf(w, "\t%s %s\n", f.goName, goType)
+		}
+	}
+	w.WriteString("}\n\n")
+	return nil
+}
+
+// fieldType returns the Go type of a struct field, Options are optional pointers.
+func (g *generator) fieldType(moveType *movebcs.TypeTag) (string, bool, error) {
+	if moveType.Kind == movebcs.StructType && moveType.Struct.Is("0x1", "option", "Option") && len(moveType.Struct.TypeParams) == 1 {
+		elem, err := g.goType(moveType.Struct.TypeParams[0])
+		if err != nil {
+			return "", false, err
+		}
+		return "*" + elem, true, nil
+	}
+	goType, err := g.goType(moveType)
+	return goType, false, err
+}
+
+func (g *generator) goType(moveType *movebcs.TypeTag) (string, error) {
+	switch moveType.Kind {
+	case movebcs.BoolType:
+		return "bool", nil
+	case movebcs.U8Type:
+		return "uint8", nil
+	case movebcs.U16Type:
+		return "uint16", nil
+	case movebcs.U32Type:
+		return "uint32", nil
+	case movebcs.U64Type:
+		return "uint64", nil
+	case movebcs.U128Type:
+		g.imports[mystenbcsImport] = true
+		return "mystenbcs.U128", nil
+	case movebcs.U256Type:
+		g.imports[mystenbcsImport] = true
+		return "mystenbcs.U256", nil
+	case movebcs.AddressType, movebcs.SignerType:
+		g.imports[modelsImport] = true
+		return "models.SuiAddressBytes", nil
+	case movebcs.VectorType:
+		if moveType.Elem.Kind == movebcs.U8Type {
+			return "[]byte", nil
+		}
+		elem, err := g.goType(moveType.Elem)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case movebcs.TypeParameterType:
+		return fmt.Sprintf("T%d", moveType.Index), nil
+	case movebcs.StructType:
+		return g.datatypeType(moveType.Struct)
+	default:
+		return "", fmt.Errorf("unknown type kind %d", moveType.Kind)
+	}
+}
+
+func (g *generator) datatypeType(structTag *movebcs.StructTag) (string, error) {
+	if structTag.Is("0x1", "option", "Option") {
+		if len(structTag.TypeParams) != 1 {
+			return "", fmt.Errorf("invalid option %s", structTag)
+		}
+		// an option is a vector of at most one element
+		elem, err := g.goType(structTag.TypeParams[0])
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	}
+
+	if mapping, ok := g.typeMappings[structTag.ID()]; ok {
+		if mapping.Import != "" {
+			g.imports[mapping.Import] = true
+		}
+		return mapping.Type, nil
+	}
+
+	if framework, ok := frameworkTypes[shortTypeID(structTag)]; ok {
+		if framework.importPath != "" {
+			g.imports[framework.importPath] = true
+		}
+		return g.instantiate(framework.goType, framework.typeArgs, structTag)
+	}
+
+	if structTag.Address == g.address {
+		d, ok := g.datatypes[structTag.Module+"::"+structTag.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown datatype %s", structTag.ID())
+		}
+		var typeArgs []int
+		for i, phantom := range d.phantoms {
+			if !phantom {
+				typeArgs = append(typeArgs, i)
+			}
+		}
+		return g.instantiate(d.goName, typeArgs, structTag)
+	}
+
+	return "", fmt.Errorf("no Go type for %s, it must be added to the type mappings", structTag.ID())
+}
+
+// instantiate returns the Go type with the Move type arguments at the indexes.
+func (g *generator) instantiate(goType string, indexes []int, structTag *movebcs.StructTag) (string, error) {
+	if len(indexes) == 0 {
+		return goType, nil
+	}
+	args := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		if index >= len(structTag.TypeParams) {
+			return "", fmt.Errorf("missing type argument %d of %s", index, structTag.ID())
+		}
+		arg, err := g.goType(structTag.TypeParams[index])
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+	}
+	return goType + "[" + strings.Join(args, ", ") + "]", nil
+}
+
+// shortTypeID returns the id of the datatype with the leading zeros of its address trimmed, as in `0x2::coin::Coin`.
+func shortTypeID(structTag *movebcs.StructTag) string {
+	address := strings.TrimLeft(strings.TrimPrefix(structTag.Address, "0x"), "0")
+	if address == "" {
+		address = "0"
+	}
+	return "0x" + address + "::" + structTag.Module + "::" + structTag.Name
+}
+
+// toCamelCase converts a Move identifier such as `pool_info` to an exported Go identifier.
+func toCamelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	if b.Len() == 0 || b.String()[0] >= '0' && b.String()[0] <= '9' {
+		return "X" + b.String()
+	}
+	return b.String()
+}
@@ -0,0 +1,44 @@
+package bind
+
+import "github.com/block-vision/sui-go-sdk/models"
+
+// The Go mirrors of the Sui framework types used by the generated code. Their fields are in the
+// order of the Move fields, phantom type parameters are dropped.
+
+// Coin mirrors 0x2::coin::Coin.
+type Coin struct {
+	Id      models.SuiAddressBytes
+	Balance uint64
+}
+
+// Table mirrors 0x2::table::Table, 0x2::object_table::ObjectTable, 0x2::bag::Bag,
+// 0x2::object_bag::ObjectBag and 0x2::table_vec::TableVec. The entries are dynamic fields of the
+// table id, they are not part of the table contents.
+type Table struct {
+	Id   models.SuiAddressBytes
+	Size uint64
+}
+
+// LinkedTable mirrors 0x2::linked_table::LinkedTable.
+type LinkedTable[K any] struct {
+	Id   models.SuiAddressBytes
+	Size uint64
+	Head *K `bcs:"optional"`
+	Tail *K `bcs:"optional"`
+}
+
+// VecMap mirrors 0x2::vec_map::VecMap.
+type VecMap[K any, V any] struct {
+	Contents []VecMapEntry[K, V]
+}
+
+// VecMapEntry mirrors 0x2::vec_map::Entry.
+type VecMapEntry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// VecSet mirrors 0x2::vec_set::VecSet.
+type VecSet[K any] struct {
+	Contents []K
+}
@@ -0,0 +1,35 @@
+// Command bcsgen generates reflection-free BCS methods for the types of a Go package annotated
+// with the `//bcs:generate` directive. It is run by go generate in the directory of the package:
+//
+//	//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+//
+// The methods are written to bcs_generated.go, which is ignored when the package is loaded.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcsgen"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "the directory of the package")
+	out := flag.String("out", bcsgen.FileName, "the output file, relative to the directory of the package")
+	flag.Parse()
+
+	if err := run(*dir, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "bcsgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, out string) error {
+	source, err := bcsgen.Generate(dir)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, out), source, 0o644)
+}
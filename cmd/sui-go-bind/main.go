@@ -0,0 +1,91 @@
+// Command sui-go-bind generates Go mirrors of the structs and enums of a Move package, and
+// functions calling its public and entry functions.
+//
+// Usage:
+//
+//	sui-go-bind -package 0x... -pkg mypackage -out mypackage/types.go
+//	sui-go-bind -modules modules.json -pkg mypackage
+//
+// The modules are fetched with `suix_getNormalizedMoveModulesByPackage`, or read from a JSON dump
+// of its result.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/bind"
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+)
+
+type typeMappings map[string]bind.TypeMapping
+
+func (m typeMappings) String() string {
+	return fmt.Sprint(map[string]bind.TypeMapping(m))
+}
+
+// Set parses `<move type>=<go type>` or `<move type>=<import path>.<go type>`.
+func (m typeMappings) Set(value string) error {
+	moveType, goType, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("expected <move type>=<go type>, got %q", value)
+	}
+	mapping := bind.TypeMapping{Type: goType}
+	if slash := strings.LastIndex(goType, "/"); slash >= 0 {
+		dot := strings.Index(goType[slash:], ".")
+		if dot < 0 {
+			return fmt.Errorf("expected <import path>.<go type>, got %q", goType)
+		}
+		mapping.Import = goType[:slash+dot]
+		mapping.Type = goType[slash+1:]
+	}
+	m[moveType] = mapping
+	return nil
+}
+
+func main() {
+	mappings := make(typeMappings)
+	packageId := flag.String("package", "", "the id of the package to fetch, and to call with -modules")
+	rpc := flag.String("rpc", constant.SuiMainnetEndpoint, "the JSON-RPC endpoint the package is fetched from")
+	modulesFile := flag.String("modules", "", "a JSON file of the normalized modules, instead of fetching the package")
+	packageName := flag.String("pkg", "bindings", "the name of the generated Go package")
+	out := flag.String("out", "", "the output file, the standard output by default")
+	flag.Var(mappings, "type", "maps a Move type of another package to a Go type, e.g. 0xabc::pool::Pool=github.com/org/pool.Pool, can be repeated")
+	flag.Parse()
+
+	if err := run(*packageId, *rpc, *modulesFile, *packageName, *out, mappings); err != nil {
+		fmt.Fprintln(os.Stderr, "sui-go-bind:", err)
+		os.Exit(1)
+	}
+}
+
+func run(packageId, rpc, modulesFile, packageName, out string, mappings typeMappings) error {
+	var modules models.GetNormalizedMoveModulesByPackageResponse
+	var err error
+	switch {
+	case modulesFile != "":
+		modules, err = bind.LoadModules(modulesFile)
+	case packageId != "":
+		modules, err = bind.FetchModules(context.Background(), sui.NewSuiClient(rpc), packageId)
+	default:
+		return fmt.Errorf("either -package or -modules is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	source, err := bind.Generate(modules, &bind.Config{PackageName: packageName, PackageID: packageId, TypeMappings: mappings})
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+	return os.WriteFile(out, source, 0644)
+}
@@ -13,12 +13,14 @@ type KeyPair byte
 const (
 	Ed25519Flag   KeyPair = 0
 	Secp256k1Flag KeyPair = 1
+	Secp256r1Flag KeyPair = 2
 	ErrorFlag     byte    = math.MaxUint8
 )
 
 const (
 	ed25519PublicKeyLength   = 32
 	secp256k1PublicKeyLength = 33
+	secp256r1PublicKeyLength = 33
 )
 
 const (
@@ -58,6 +60,17 @@ func FetchKeyPair(value string) (models.SuiKeyPair, error) {
 			PublicKeyBase64: pbInBase64,
 			Address:         fromPublicKeyBytesToAddress(pb, byte(Secp256k1Flag)),
 		}, nil
+	case byte(Secp256r1Flag):
+		pb := result[1 : secp256r1PublicKeyLength+1]
+		sk := result[1+secp256r1PublicKeyLength:]
+		pbInBase64 := encodeBase64(pb)
+		return models.SuiKeyPair{
+			Flag:            byte(Secp256r1Flag),
+			PrivateKey:      sk,
+			PublicKey:       pb,
+			PublicKeyBase64: pbInBase64,
+			Address:         fromPublicKeyBytesToAddress(pb, byte(Secp256r1Flag)),
+		}, nil
 	default:
 		return models.SuiKeyPair{}, sui_error.ErrInvalidEncryptFlag
 	}
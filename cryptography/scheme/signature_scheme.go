@@ -8,12 +8,14 @@ const (
 	Secp256r1 SignatureScheme = "Secp256r1"
 	MultiSig  SignatureScheme = "MultiSig"
 	ZkLogin   SignatureScheme = "ZkLogin"
+	Passkey   SignatureScheme = "Passkey"
 )
 
 var SignatureSchemeToSize = map[SignatureScheme]int{
 	ED25519:   32,
 	Secp256k1: 33,
 	Secp256r1: 33,
+	Passkey:   33,
 }
 
 var SignatureSchemeToFlag = map[SignatureScheme]byte{
@@ -22,6 +24,7 @@ var SignatureSchemeToFlag = map[SignatureScheme]byte{
 	Secp256r1: 0x02,
 	MultiSig:  0x03,
 	ZkLogin:   0x05,
+	Passkey:   0x06,
 }
 
 var SignatureFlagToScheme = map[byte]SignatureScheme{
@@ -30,4 +33,5 @@ var SignatureFlagToScheme = map[byte]SignatureScheme{
 	0x02: Secp256r1,
 	0x03: MultiSig,
 	0x05: ZkLogin,
+	0x06: Passkey,
 }
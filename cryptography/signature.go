@@ -5,25 +5,36 @@ import (
 	"strings"
 
 	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/multisig"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/passkey"
 	"github.com/block-vision/sui-go-sdk/zklogin"
 )
 
 type SignaturePubkeyPair struct {
-	SignatureScheme scheme.SignatureScheme
-	Signature       []byte
-	PubKey          []byte
+	SerializedSignature string
+	SignatureScheme     scheme.SignatureScheme
+	// Signature is the raw signature for ED25519, Secp256k1 and Secp256r1,
+	// and the full serialized signature for MultiSig, ZkLogin and Passkey.
+	Signature []byte
+	PubKey    []byte
+	ZkLogin   *zklogin.ZkLoginSignature
+	MultiSig  *multisig.MultiSigStruct
 }
 
-func parseSerializedSignature(serializedSignature string) (*SignaturePubkeyPair, error) {
+// ParseSerializedSignature parses a base64 serialized signature `flag || signature || pubkey`.
+func ParseSerializedSignature(serializedSignature string) (*SignaturePubkeyPair, error) {
 	if strings.EqualFold(serializedSignature, "") {
-		return nil, fmt.Errorf("multiSig is not supported")
+		return nil, fmt.Errorf("empty signature")
 	}
 
 	bytes, err := mystenbcs.FromBase64(serializedSignature)
 	if err != nil {
 		return nil, err
 	}
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("empty signature")
+	}
 
 	signatureScheme, ok := scheme.SignatureFlagToScheme[bytes[0]]
 	if !ok {
@@ -31,34 +42,61 @@ func parseSerializedSignature(serializedSignature string) (*SignaturePubkeyPair,
 	}
 
 	switch signatureScheme {
-	case "ZkLogin":
-		parsedSerializedZkLoginSignature, err := zklogin.ParseSerializedZkLoginSignature(serializedSignature)
+	case scheme.MultiSig:
+		parsedMultiSigSignature, err := multisig.ParseSerializedMultiSigSignature(bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignaturePubkeyPair{
+			SerializedSignature: serializedSignature,
+			SignatureScheme:     parsedMultiSigSignature.SignatureScheme,
+			Signature:           parsedMultiSigSignature.Signature,
+			PubKey:              parsedMultiSigSignature.PubKey,
+			MultiSig:            parsedMultiSigSignature.MultiSig,
+		}, nil
+	case scheme.ZkLogin:
+		parsedSerializedZkLoginSignature, err := zklogin.ParseSerializedZkLoginSignature(bytes)
 		if err != nil {
 			return nil, err
 		}
 
 		return &SignaturePubkeyPair{
-			SignatureScheme: parsedSerializedZkLoginSignature.SignatureScheme,
-			Signature:       parsedSerializedZkLoginSignature.Signature,
-			PubKey:          parsedSerializedZkLoginSignature.PubKey,
+			SerializedSignature: serializedSignature,
+			SignatureScheme:     parsedSerializedZkLoginSignature.SignatureScheme,
+			Signature:           parsedSerializedZkLoginSignature.Signature,
+			PubKey:              parsedSerializedZkLoginSignature.PubKey,
+			ZkLogin:             parsedSerializedZkLoginSignature.ZkLogin,
 		}, nil
-	case "ED25519":
-		fallthrough
-	case "Secp256k1":
-		fallthrough
-	case "Secp256r1":
+	case scheme.Passkey:
+		parsedPasskeySignature, err := passkey.ParseSerializedPasskeySignature(bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignaturePubkeyPair{
+			SerializedSignature: serializedSignature,
+			SignatureScheme:     parsedPasskeySignature.SignatureScheme,
+			Signature:           parsedPasskeySignature.Signature,
+			PubKey:              parsedPasskeySignature.PubKey,
+		}, nil
+	case scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1:
 		size, ok := scheme.SignatureSchemeToSize[signatureScheme]
 		if !ok {
 			return nil, fmt.Errorf("signature scheme is not supported")
 		}
+		if len(bytes) != 1+64+size {
+			return nil, fmt.Errorf("invalid %s signature length: %d", signatureScheme, len(bytes))
+		}
 
 		signature := bytes[1 : len(bytes)-size]
 		pubKeyBytes := bytes[1+len(signature):]
 
 		keyPair := &SignaturePubkeyPair{
-			SignatureScheme: signatureScheme,
-			Signature:       signature,
-			PubKey:          pubKeyBytes,
+			SerializedSignature: serializedSignature,
+			SignatureScheme:     signatureScheme,
+			Signature:           signature,
+			PubKey:              pubKeyBytes,
 		}
 
 		return keyPair, nil
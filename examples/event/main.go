@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/filter"
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
 	"github.com/block-vision/sui-go-sdk/utils"
@@ -31,10 +32,14 @@ func SuiGetEvents() {
 }
 
 func SuiXQueryEvents() {
+	eventFilter, err := filter.Event.MoveEventType("0x3::validator::StakingRequestEvent").BuildQuery()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
 	rsp, err := cli.SuiXQueryEvents(ctx, models.SuiXQueryEventsRequest{
-		SuiEventFilter: models.EventFilterByMoveEventType{
-			MoveEventType: "0x3::validator::StakingRequestEvent",
-		},
+		SuiEventFilter:  eventFilter,
 		Limit:           5,
 		DescendingOrder: false,
 	})
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/remotesigner"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+var ctx = context.Background()
+
+func main() {
+	RunServer()
+	//SignWithRemoteSigner()
+}
+
+// RunServer runs the reference server on the isolated signing host.
+func RunServer() {
+	localSigner, err := signer.NewSignerWithSecretKey(os.Getenv("SUI_PRIVATE_KEY"))
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	server := remotesigner.NewServer(&remotesigner.ServerOptions{
+		AuthToken:   "change-me",
+		AuditLogger: remotesigner.NewJSONAuditLogger(os.Stdout),
+	})
+	// only transactions may be signed with this key
+	server.AddSigner(localSigner, remotesigner.Policy{
+		AllowedScopes: []constant.IntentScope{constant.TransactionDataIntentScope},
+	})
+
+	fmt.Println(http.ListenAndServe("127.0.0.1:9000", server))
+}
+
+// SignWithRemoteSigner signs a transaction with a key held by the server. The returned signer
+// can be passed to the transaction builder or to SignAndExecuteTransactionBlock.
+func SignWithRemoteSigner() {
+	client := remotesigner.NewClient("http://127.0.0.1:9000", &remotesigner.ClientOptions{AuthToken: "change-me"})
+	keys, err := client.Keys(ctx)
+	if err != nil || len(keys) == 0 {
+		fmt.Println("no keys on the remote signer", err)
+		return
+	}
+
+	remoteSigner, err := client.Signer(ctx, keys[0].Address)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	signature, err := models.SignWithIntent(ctx, remoteSigner, []byte("tx bytes"), constant.TransactionDataIntentScope)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	fmt.Println("signature:", signature)
+}
@@ -0,0 +1,177 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// EventFilters builds the event filters, through [Event].
+type EventFilters struct{}
+
+// Event builds the filters of suix_queryEvents and suix_subscribeEvent.
+var Event EventFilters
+
+// EventFilter is a filter of events, built by [Event] and combined with [EventFilter.And] and
+// [EventFilter.Or].
+type EventFilter struct {
+	variant string
+	value   any
+	// filters are the filters of All, Any, And and Or
+	filters []EventFilter
+	err     error
+}
+
+const (
+	eventSender          = "Sender"
+	eventTransaction     = "Transaction"
+	eventPackage         = "Package"
+	eventMoveModule      = "MoveModule"
+	eventMoveEventType   = "MoveEventType"
+	eventMoveEventModule = "MoveEventModule"
+	eventMoveEventField  = "MoveEventField"
+	eventTimeRange       = "TimeRange"
+	eventAll             = "All"
+	eventAny             = "Any"
+	eventAnd             = "And"
+	eventOr              = "Or"
+)
+
+func newEventFilter(variant string, value any, err error) EventFilter {
+	if err != nil {
+		err = fmt.Errorf("invalid %s event filter: %v", variant, err)
+	}
+	return EventFilter{variant: variant, value: value, err: err}
+}
+
+// Sender matches the events of the transactions sent by the address.
+func (EventFilters) Sender(address string) EventFilter {
+	address, err := normalizeAddress(address)
+	return newEventFilter(eventSender, address, err)
+}
+
+// Transaction matches the events of the transaction.
+func (EventFilters) Transaction(digest string) EventFilter {
+	return newEventFilter(eventTransaction, digest, checkDigest(digest))
+}
+
+// Package matches the events emitted by the modules of the package.
+func (EventFilters) Package(packageId string) EventFilter {
+	packageId, err := normalizeAddress(packageId)
+	return newEventFilter(eventPackage, packageId, err)
+}
+
+// MoveModule matches the events of the transactions calling the module.
+func (EventFilters) MoveModule(pkg, module string) EventFilter {
+	value, err := moveModule(pkg, module)
+	return newEventFilter(eventMoveModule, value, err)
+}
+
+// MoveEventType matches the events of the Move type, e.g. `0x3::validator::StakingRequestEvent`.
+func (EventFilters) MoveEventType(eventType string) EventFilter {
+	eventType, err := normalizeStructType(eventType)
+	return newEventFilter(eventMoveEventType, eventType, err)
+}
+
+// MoveEventModule matches the events whose type is defined in the module.
+func (EventFilters) MoveEventModule(pkg, module string) EventFilter {
+	value, err := moveModule(pkg, module)
+	return newEventFilter(eventMoveEventModule, value, err)
+}
+
+// MoveEventField matches the events whose field at the JSON pointer path, e.g. `/name`, has the
+// value.
+func (EventFilters) MoveEventField(path string, value any) EventFilter {
+	var err error
+	if !strings.HasPrefix(path, "/") {
+		err = fmt.Errorf("invalid path %q, it must start with /", path)
+	}
+	return newEventFilter(eventMoveEventField, moveEventFieldJSON{Path: path, Value: value}, err)
+}
+
+// TimeRange matches the events emitted from start, included, to end, excluded.
+func (EventFilters) TimeRange(start, end time.Time) EventFilter {
+	var err error
+	if end.Before(start) {
+		err = fmt.Errorf("end %v before start %v", end, start)
+	}
+	return newEventFilter(eventTimeRange, timeRangeJSON{
+		StartTime: strconv.FormatInt(start.UnixMilli(), 10),
+		EndTime:   strconv.FormatInt(end.UnixMilli(), 10),
+	}, err)
+}
+
+// All matches the events matched by all the filters, every event without filters.
+func (EventFilters) All(filters ...EventFilter) EventFilter {
+	return EventFilter{variant: eventAll, filters: append([]EventFilter{}, filters...)}
+}
+
+// Any matches the events matched by any of the filters.
+func (EventFilters) Any(filters ...EventFilter) EventFilter {
+	return EventFilter{variant: eventAny, filters: append([]EventFilter{}, filters...)}
+}
+
+// And matches the events matched by both filters.
+func (f EventFilter) And(other EventFilter) EventFilter {
+	return EventFilter{variant: eventAnd, filters: []EventFilter{f, other}}
+}
+
+// Or matches the events matched by either filter.
+func (f EventFilter) Or(other EventFilter) EventFilter {
+	return EventFilter{variant: eventOr, filters: []EventFilter{f, other}}
+}
+
+// Build returns the JSON of the filter for suix_subscribeEvent, which supports all the filters.
+func (f EventFilter) Build() (models.SuiEventFilter, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	switch f.variant {
+	case "":
+		return nil, errors.New("empty event filter")
+	case eventAll, eventAny, eventAnd, eventOr:
+		filters := make([]models.SuiEventFilter, len(f.filters))
+		for i, filter := range f.filters {
+			var err error
+			if filters[i], err = filter.Build(); err != nil {
+				return nil, err
+			}
+		}
+		return models.SuiEventFilter{f.variant: filters}, nil
+	default:
+		return models.SuiEventFilter{f.variant: f.value}, nil
+	}
+}
+
+// BuildQuery returns the JSON of the filter for suix_queryEvents. The full node queries its
+// indexes with one filter other than Package and MoveEventField, or with All of no filters for
+// every event.
+func (f EventFilter) BuildQuery() (models.SuiEventFilter, error) {
+	filter, err := f.Build()
+	if err != nil {
+		return nil, err
+	}
+	switch f.variant {
+	case eventSender, eventTransaction, eventMoveModule, eventMoveEventType, eventMoveEventModule, eventTimeRange:
+		return filter, nil
+	case eventAll:
+		if len(f.filters) == 0 {
+			return filter, nil
+		}
+	}
+	return nil, fmt.Errorf("the %s event filter is not supported by suix_queryEvents, only by subscriptions", f.variant)
+}
+
+type moveEventFieldJSON struct {
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+type timeRangeJSON struct {
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
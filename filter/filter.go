@@ -0,0 +1,86 @@
+// Package filter builds the filters of the JSON-RPC queries and subscriptions: the event filters of
+// suix_queryEvents and suix_subscribeEvent, the transaction filters of suix_queryTransactionBlocks
+// and suix_subscribeTransaction, and the object filters of suix_getOwnedObjects.
+//
+// The filters are built from [Event], [Transaction] and [Object], and combined with their methods:
+//
+//	eventFilter, err := filter.Event.MoveEventType("0x3::validator::StakingRequestEvent").
+//		And(filter.Event.Sender(sender)).
+//		Build()
+//
+// An invalid Move type, address or digest is reported by Build, which returns the JSON of the
+// filter expected by the node.
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/block-vision/sui-go-sdk/movebcs"
+)
+
+// normalizeAddress checks an address or an object id, and pads it to 64 hex digits.
+func normalizeAddress(address string) (string, error) {
+	value, ok := strings.CutPrefix(strings.ToLower(address), "0x")
+	if !ok || value == "" || len(value) > 64 {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+	for _, c := range value {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return "", fmt.Errorf("invalid address %q", address)
+		}
+	}
+	return "0x" + strings.Repeat("0", 64-len(value)) + value, nil
+}
+
+// normalizeStructType checks a Move datatype, e.g. `0x2::coin::Coin<0x2::sui::SUI>`, and returns
+// it with normalized addresses. The type arguments may be omitted.
+func normalizeStructType(value string) (string, error) {
+	structTag, err := movebcs.ParseStructTag(value)
+	if err != nil {
+		return "", err
+	}
+	return structTag.String(), nil
+}
+
+// checkIdentifier checks the name of a Move module or function.
+func checkIdentifier(name string) error {
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return fmt.Errorf("invalid identifier %q", name)
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return fmt.Errorf("invalid identifier %q", name)
+		}
+	}
+	return nil
+}
+
+// checkDigest checks a base58 transaction digest.
+func checkDigest(digest string) error {
+	data, err := base58.Decode(digest)
+	if err != nil || len(data) != 32 {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	return nil
+}
+
+// moveModule checks the package and the module of a filter.
+func moveModule(pkg, module string) (moveModuleJSON, error) {
+	pkg, err := normalizeAddress(pkg)
+	if err != nil {
+		return moveModuleJSON{}, err
+	}
+	if err := checkIdentifier(module); err != nil {
+		return moveModuleJSON{}, err
+	}
+	return moveModuleJSON{Package: pkg, Module: module}, nil
+}
+
+type moveModuleJSON struct {
+	Package string `json:"package"`
+	Module  string `json:"module"`
+}
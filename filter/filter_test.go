@@ -0,0 +1,191 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	address = "0x0000000000000000000000000000000000000000000000000000000000000005"
+	sui     = "0x0000000000000000000000000000000000000000000000000000000000000002"
+	digest  = "7h7bfWSkpCAHxnSAFBFmyrSnKPBMiMs3gZ8hpjmeEPVd"
+)
+
+func TestEventFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter EventFilter
+		json   string
+		// query is false when suix_queryEvents doesn't support the filter
+		query   bool
+		wantErr bool
+	}{
+		{name: "sender", filter: Event.Sender("0x5"), json: `{"Sender":"` + address + `"}`, query: true},
+		{name: "transaction", filter: Event.Transaction(digest), json: `{"Transaction":"` + digest + `"}`, query: true},
+		{name: "package", filter: Event.Package("0x2"), json: `{"Package":"` + sui + `"}`},
+		{name: "move module", filter: Event.MoveModule("0x2", "coin"), json: `{"MoveModule":{"package":"` + sui + `","module":"coin"}}`, query: true},
+		{name: "move event type", filter: Event.MoveEventType("0x2::coin::Event<0x2::sui::SUI>"), json: `{"MoveEventType":"` + sui + `::coin::Event<` + sui + `::sui::SUI>"}`, query: true},
+		{name: "move event module", filter: Event.MoveEventModule("0x2", "coin"), json: `{"MoveEventModule":{"package":"` + sui + `","module":"coin"}}`, query: true},
+		{name: "move event field", filter: Event.MoveEventField("/name", "NFT"), json: `{"MoveEventField":{"path":"/name","value":"NFT"}}`},
+		{name: "time range", filter: Event.TimeRange(time.UnixMilli(1685959791871), time.UnixMilli(1685959791872)), json: `{"TimeRange":{"startTime":"1685959791871","endTime":"1685959791872"}}`, query: true},
+		{name: "all events", filter: Event.All(), json: `{"All":[]}`, query: true},
+		{name: "all", filter: Event.All(Event.Sender("0x5"), Event.Package("0x2")), json: `{"All":[{"Sender":"` + address + `"},{"Package":"` + sui + `"}]}`},
+		{name: "any", filter: Event.Any(Event.Sender("0x5")), json: `{"Any":[{"Sender":"` + address + `"}]}`},
+		{
+			name:   "and or",
+			filter: Event.MoveEventType("0x2::coin::Event").And(Event.Sender("0x5")).Or(Event.Package("0x2")),
+			json:   `{"Or":[{"And":[{"MoveEventType":"` + sui + `::coin::Event"},{"Sender":"` + address + `"}]},{"Package":"` + sui + `"}]}`,
+		},
+		{name: "invalid address", filter: Event.Sender("5"), wantErr: true},
+		{name: "invalid digest", filter: Event.Transaction("0x5"), wantErr: true},
+		{name: "invalid module", filter: Event.MoveModule("0x2", "coin::x"), wantErr: true},
+		{name: "invalid event type", filter: Event.MoveEventType("u64"), wantErr: true},
+		{name: "event type without name", filter: Event.MoveEventType("0x2::coin"), wantErr: true},
+		{name: "invalid path", filter: Event.MoveEventField("name", 1), wantErr: true},
+		{name: "invalid time range", filter: Event.TimeRange(time.UnixMilli(2), time.UnixMilli(1)), wantErr: true},
+		{name: "invalid nested filter", filter: Event.Sender("0x5").And(Event.MoveEventType("0x2::coin")), wantErr: true},
+		{name: "empty", filter: EventFilter{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.filter.Build()
+			query, queryErr := tt.filter.BuildQuery()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, queryErr)
+				return
+			}
+			require.NoError(t, err)
+			requireJSON(t, tt.json, filter)
+
+			if tt.query {
+				require.NoError(t, queryErr)
+				requireJSON(t, tt.json, query)
+			} else {
+				require.Error(t, queryErr)
+			}
+		})
+	}
+}
+
+func TestTransactionFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter TransactionFilter
+		json   string
+		// query is false when the full node doesn't support the filter
+		query   bool
+		wantErr bool
+	}{
+		{name: "checkpoint", filter: Transaction.Checkpoint(42), json: `{"Checkpoint":"42"}`, query: true},
+		{name: "move function", filter: Transaction.MoveFunction("0x2", "coin", "split"), json: `{"MoveFunction":{"package":"` + sui + `","module":"coin","function":"split"}}`, query: true},
+		{name: "move package", filter: Transaction.MoveFunction("0x2", "", ""), json: `{"MoveFunction":{"package":"` + sui + `","module":null,"function":null}}`, query: true},
+		{name: "input object", filter: Transaction.InputObject("0x2"), json: `{"InputObject":"` + sui + `"}`, query: true},
+		{name: "changed object", filter: Transaction.ChangedObject("0x2"), json: `{"ChangedObject":"` + sui + `"}`, query: true},
+		{name: "affected object", filter: Transaction.AffectedObject("0x2"), json: `{"AffectedObject":"` + sui + `"}`, query: true},
+		{name: "from address", filter: Transaction.FromAddress("0x5"), json: `{"FromAddress":"` + address + `"}`, query: true},
+		{name: "to address", filter: Transaction.ToAddress("0x5"), json: `{"ToAddress":"` + address + `"}`, query: true},
+		{name: "from and to address", filter: Transaction.ToAddress("0x2").And(Transaction.FromAddress("0x5")), json: `{"FromAndToAddress":{"from":"` + address + `","to":"` + sui + `"}}`},
+		{name: "from or to address", filter: Transaction.FromAddress("0x5").Or(Transaction.ToAddress("0x5")), json: `{"FromOrToAddress":{"addr":"` + address + `"}}`},
+		{name: "transaction kind", filter: Transaction.TransactionKind("ProgrammableTransaction"), json: `{"TransactionKind":"ProgrammableTransaction"}`},
+		{name: "transaction kinds", filter: Transaction.TransactionKindIn("ChangeEpoch", "Genesis"), json: `{"TransactionKindIn":["ChangeEpoch","Genesis"]}`},
+		{name: "function without module", filter: Transaction.MoveFunction("0x2", "", "split"), wantErr: true},
+		{name: "invalid object", filter: Transaction.InputObject("0xg"), wantErr: true},
+		{name: "no kinds", filter: Transaction.TransactionKindIn(), wantErr: true},
+		{name: "and of other filters", filter: Transaction.FromAddress("0x5").And(Transaction.InputObject("0x2")), wantErr: true},
+		{name: "or of different addresses", filter: Transaction.FromAddress("0x5").Or(Transaction.ToAddress("0x2")), wantErr: true},
+		{name: "and of an invalid filter", filter: Transaction.FromAddress("0x5").And(Transaction.ToAddress("")), wantErr: true},
+		{name: "empty", filter: TransactionFilter{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.filter.Build()
+			query, queryErr := tt.filter.BuildQuery()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, queryErr)
+				return
+			}
+			require.NoError(t, err)
+			requireJSON(t, tt.json, filter)
+
+			if tt.query {
+				require.NoError(t, queryErr)
+				requireJSON(t, tt.json, query)
+			} else {
+				require.Error(t, queryErr)
+			}
+		})
+	}
+}
+
+func TestObjectFilter(t *testing.T) {
+	coin := Object.StructType("0x2::coin::Coin")
+	tests := []struct {
+		name    string
+		filter  ObjectFilter
+		json    string
+		wantErr bool
+	}{
+		{name: "package", filter: Object.Package("0x2"), json: `{"Package":"` + sui + `"}`},
+		{name: "move module", filter: Object.MoveModule("0x2", "coin"), json: `{"MoveModule":{"package":"` + sui + `","module":"coin"}}`},
+		{name: "struct type", filter: coin, json: `{"StructType":"` + sui + `::coin::Coin"}`},
+		{name: "address owner", filter: Object.AddressOwner("0x5"), json: `{"AddressOwner":"` + address + `"}`},
+		{name: "object owner", filter: Object.ObjectOwner("0x2"), json: `{"ObjectOwner":"` + sui + `"}`},
+		{name: "object id", filter: Object.ObjectId("0x2"), json: `{"ObjectId":"` + sui + `"}`},
+		{name: "object ids", filter: Object.ObjectIds("0x2", "0x5"), json: `{"ObjectIds":["` + sui + `","` + address + `"]}`},
+		{name: "version", filter: Object.Version(7), json: `{"Version":"7"}`},
+		{
+			name:   "and",
+			filter: coin.And(Object.Package("0x2")).And(Object.Version(7)),
+			json:   `{"MatchAll":[{"StructType":"` + sui + `::coin::Coin"},{"Package":"` + sui + `"},{"Version":"7"}]}`,
+		},
+		{
+			name:   "or not",
+			filter: coin.Or(Object.ObjectId("0x2")).Not(),
+			json:   `{"MatchNone":[{"MatchAny":[{"StructType":"` + sui + `::coin::Coin"},{"ObjectId":"` + sui + `"}]}]}`,
+		},
+		{name: "invalid struct type", filter: Object.StructType("vector<u8>"), wantErr: true},
+		{name: "no object ids", filter: Object.ObjectIds(), wantErr: true},
+		{name: "invalid object id", filter: Object.ObjectIds("0x2", "x"), wantErr: true},
+		{name: "invalid nested filter", filter: Object.MatchAny(coin, Object.MoveModule("0x2", "")), wantErr: true},
+		{name: "empty", filter: ObjectFilter{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.filter.Build()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			requireJSON(t, tt.json, filter)
+		})
+	}
+
+	// combining a filter doesn't change the filters combined with it
+	all := Object.MatchAll(coin, coin)
+	first, second := all.And(Object.Version(1)), all.And(Object.Version(2))
+	requireObjectFilter(t, `{"MatchAll":[{"StructType":"`+sui+`::coin::Coin"},{"StructType":"`+sui+`::coin::Coin"},{"Version":"1"}]}`, first)
+	requireObjectFilter(t, `{"MatchAll":[{"StructType":"`+sui+`::coin::Coin"},{"StructType":"`+sui+`::coin::Coin"},{"Version":"2"}]}`, second)
+}
+
+func requireJSON(t *testing.T, expected string, filter any) {
+	t.Helper()
+	data, err := json.Marshal(filter)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(data))
+}
+
+func requireObjectFilter(t *testing.T, expected string, filter ObjectFilter) {
+	t.Helper()
+	built, err := filter.Build()
+	require.NoError(t, err)
+	requireJSON(t, expected, built)
+}
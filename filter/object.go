@@ -0,0 +1,163 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// ObjectFilters builds the object filters, through [Object].
+type ObjectFilters struct{}
+
+// Object builds the filters of suix_getOwnedObjects.
+var Object ObjectFilters
+
+// ObjectFilter is a filter of objects, built by [Object] and combined with [ObjectFilter.And],
+// [ObjectFilter.Or] and [ObjectFilter.Not].
+type ObjectFilter struct {
+	variant string
+	value   any
+	// filters are the filters of MatchAll, MatchAny and MatchNone
+	filters []ObjectFilter
+	err     error
+}
+
+const (
+	objectMatchAll     = "MatchAll"
+	objectMatchAny     = "MatchAny"
+	objectMatchNone    = "MatchNone"
+	objectPackage      = "Package"
+	objectMoveModule   = "MoveModule"
+	objectStructType   = "StructType"
+	objectAddressOwner = "AddressOwner"
+	objectObjectOwner  = "ObjectOwner"
+	objectObjectId     = "ObjectId"
+	objectObjectIds    = "ObjectIds"
+	objectVersion      = "Version"
+)
+
+func newObjectFilter(variant string, value any, err error) ObjectFilter {
+	if err != nil {
+		err = fmt.Errorf("invalid %s object filter: %v", variant, err)
+	}
+	return ObjectFilter{variant: variant, value: value, err: err}
+}
+
+func newAddressObjectFilter(variant, address string) ObjectFilter {
+	address, err := normalizeAddress(address)
+	return newObjectFilter(variant, address, err)
+}
+
+// Package matches the objects whose type is defined in the package.
+func (ObjectFilters) Package(packageId string) ObjectFilter {
+	return newAddressObjectFilter(objectPackage, packageId)
+}
+
+// MoveModule matches the objects whose type is defined in the module.
+func (ObjectFilters) MoveModule(pkg, module string) ObjectFilter {
+	value, err := moveModule(pkg, module)
+	return newObjectFilter(objectMoveModule, value, err)
+}
+
+// StructType matches the objects of the Move type. Without type arguments, e.g. `0x2::coin::Coin`,
+// it matches the type with any of them.
+func (ObjectFilters) StructType(structType string) ObjectFilter {
+	structType, err := normalizeStructType(structType)
+	return newObjectFilter(objectStructType, structType, err)
+}
+
+// AddressOwner matches the objects owned by the address.
+func (ObjectFilters) AddressOwner(address string) ObjectFilter {
+	return newAddressObjectFilter(objectAddressOwner, address)
+}
+
+// ObjectOwner matches the objects owned by the object.
+func (ObjectFilters) ObjectOwner(objectId string) ObjectFilter {
+	return newAddressObjectFilter(objectObjectOwner, objectId)
+}
+
+// ObjectId matches the object.
+func (ObjectFilters) ObjectId(objectId string) ObjectFilter {
+	return newAddressObjectFilter(objectObjectId, objectId)
+}
+
+// ObjectIds matches the objects.
+func (ObjectFilters) ObjectIds(objectIds ...string) ObjectFilter {
+	var err error
+	if len(objectIds) == 0 {
+		err = errors.New("no object ids")
+	}
+	ids := make([]string, len(objectIds))
+	for i, objectId := range objectIds {
+		if err == nil {
+			ids[i], err = normalizeAddress(objectId)
+		}
+	}
+	return newObjectFilter(objectObjectIds, ids, err)
+}
+
+// Version matches the objects of the version.
+func (ObjectFilters) Version(version uint64) ObjectFilter {
+	return newObjectFilter(objectVersion, strconv.FormatUint(version, 10), nil)
+}
+
+// MatchAll matches the objects matched by all the filters.
+func (ObjectFilters) MatchAll(filters ...ObjectFilter) ObjectFilter {
+	return ObjectFilter{variant: objectMatchAll, filters: append([]ObjectFilter{}, filters...)}
+}
+
+// MatchAny matches the objects matched by any of the filters.
+func (ObjectFilters) MatchAny(filters ...ObjectFilter) ObjectFilter {
+	return ObjectFilter{variant: objectMatchAny, filters: append([]ObjectFilter{}, filters...)}
+}
+
+// MatchNone matches the objects matched by none of the filters.
+func (ObjectFilters) MatchNone(filters ...ObjectFilter) ObjectFilter {
+	return ObjectFilter{variant: objectMatchNone, filters: append([]ObjectFilter{}, filters...)}
+}
+
+// And matches the objects matched by both filters, it extends a MatchAll filter.
+func (f ObjectFilter) And(other ObjectFilter) ObjectFilter {
+	if f.variant == objectMatchAll {
+		return Object.MatchAll(append(slices.Clip(f.filters), other)...)
+	}
+	return Object.MatchAll(f, other)
+}
+
+// Or matches the objects matched by either filter, it extends a MatchAny filter.
+func (f ObjectFilter) Or(other ObjectFilter) ObjectFilter {
+	if f.variant == objectMatchAny {
+		return Object.MatchAny(append(slices.Clip(f.filters), other)...)
+	}
+	return Object.MatchAny(f, other)
+}
+
+// Not matches the objects the filter doesn't match.
+func (f ObjectFilter) Not() ObjectFilter {
+	return Object.MatchNone(f)
+}
+
+// Build returns the JSON of the filter for the query of suix_getOwnedObjects.
+func (f ObjectFilter) Build() (models.SuiObjectDataFilter, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	switch f.variant {
+	case "":
+		return nil, errors.New("empty object filter")
+	case objectMatchAll, objectMatchAny, objectMatchNone:
+		filters := make([]models.SuiObjectDataFilter, len(f.filters))
+		for i, filter := range f.filters {
+			var err error
+			if filters[i], err = filter.Build(); err != nil {
+				return nil, err
+			}
+		}
+		return models.SuiObjectDataFilter{f.variant: filters}, nil
+	default:
+		return models.SuiObjectDataFilter{f.variant: f.value}, nil
+	}
+}
@@ -0,0 +1,207 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// TransactionFilters builds the transaction filters, through [Transaction].
+type TransactionFilters struct{}
+
+// Transaction builds the filters of suix_queryTransactionBlocks and suix_subscribeTransaction.
+var Transaction TransactionFilters
+
+// TransactionFilter is a filter of transactions, built by [Transaction]. The node doesn't combine
+// them, except a sender and a recipient with [TransactionFilter.And] and [TransactionFilter.Or].
+type TransactionFilter struct {
+	variant string
+	value   any
+	// address is the address of FromAddress and ToAddress, which combine
+	address string
+	err     error
+}
+
+const (
+	transactionCheckpoint        = "Checkpoint"
+	transactionMoveFunction      = "MoveFunction"
+	transactionInputObject       = "InputObject"
+	transactionChangedObject     = "ChangedObject"
+	transactionAffectedObject    = "AffectedObject"
+	transactionFromAddress       = "FromAddress"
+	transactionToAddress         = "ToAddress"
+	transactionFromAndToAddress  = "FromAndToAddress"
+	transactionFromOrToAddress   = "FromOrToAddress"
+	transactionTransactionKind   = "TransactionKind"
+	transactionTransactionKindIn = "TransactionKindIn"
+)
+
+func newTransactionFilter(variant string, value any, err error) TransactionFilter {
+	if err != nil {
+		err = fmt.Errorf("invalid %s transaction filter: %v", variant, err)
+	}
+	return TransactionFilter{variant: variant, value: value, err: err}
+}
+
+func newObjectTransactionFilter(variant, objectId string) TransactionFilter {
+	objectId, err := normalizeAddress(objectId)
+	return newTransactionFilter(variant, objectId, err)
+}
+
+func newAddressTransactionFilter(variant, address string) TransactionFilter {
+	address, err := normalizeAddress(address)
+	filter := newTransactionFilter(variant, address, err)
+	filter.address = address
+	return filter
+}
+
+// Checkpoint matches the transactions of the checkpoint.
+func (TransactionFilters) Checkpoint(sequenceNumber uint64) TransactionFilter {
+	return newTransactionFilter(transactionCheckpoint, strconv.FormatUint(sequenceNumber, 10), nil)
+}
+
+// MoveFunction matches the transactions calling the function. An empty module matches any function
+// of the package, an empty function any function of the module.
+func (TransactionFilters) MoveFunction(pkg, module, function string) TransactionFilter {
+	pkg, err := normalizeAddress(pkg)
+	value := models.MoveFunction{Package: pkg}
+	if err == nil && module != "" {
+		err = checkIdentifier(module)
+		value.Module = &module
+	}
+	if err == nil && function != "" {
+		if module == "" {
+			err = errors.New("a function needs its module")
+		} else {
+			err = checkIdentifier(function)
+		}
+		value.Function = &function
+	}
+	return newTransactionFilter(transactionMoveFunction, value, err)
+}
+
+// InputObject matches the transactions taking the object as input.
+func (TransactionFilters) InputObject(objectId string) TransactionFilter {
+	return newObjectTransactionFilter(transactionInputObject, objectId)
+}
+
+// ChangedObject matches the transactions creating, mutating or unwrapping the object.
+func (TransactionFilters) ChangedObject(objectId string) TransactionFilter {
+	return newObjectTransactionFilter(transactionChangedObject, objectId)
+}
+
+// AffectedObject matches the transactions taking or changing the object.
+func (TransactionFilters) AffectedObject(objectId string) TransactionFilter {
+	return newObjectTransactionFilter(transactionAffectedObject, objectId)
+}
+
+// FromAddress matches the transactions sent by the address.
+func (TransactionFilters) FromAddress(address string) TransactionFilter {
+	return newAddressTransactionFilter(transactionFromAddress, address)
+}
+
+// ToAddress matches the transactions sending objects to the address.
+func (TransactionFilters) ToAddress(address string) TransactionFilter {
+	return newAddressTransactionFilter(transactionToAddress, address)
+}
+
+// FromAndToAddress matches the transactions sent by from to the address to.
+func (TransactionFilters) FromAndToAddress(from, to string) TransactionFilter {
+	from, err := normalizeAddress(from)
+	if err == nil {
+		to, err = normalizeAddress(to)
+	}
+	return newTransactionFilter(transactionFromAndToAddress, fromAndToAddressJSON{From: from, To: to}, err)
+}
+
+// FromOrToAddress matches the transactions sent by or to the address.
+func (TransactionFilters) FromOrToAddress(address string) TransactionFilter {
+	address, err := normalizeAddress(address)
+	return newTransactionFilter(transactionFromOrToAddress, fromOrToAddressJSON{Addr: address}, err)
+}
+
+// TransactionKind matches the transactions of the kind, e.g. `ProgrammableTransaction`.
+func (TransactionFilters) TransactionKind(kind string) TransactionFilter {
+	return newTransactionFilter(transactionTransactionKind, kind, checkIdentifier(kind))
+}
+
+// TransactionKindIn matches the transactions of the kinds.
+func (TransactionFilters) TransactionKindIn(kinds ...string) TransactionFilter {
+	var err error
+	if len(kinds) == 0 {
+		err = errors.New("no kinds")
+	}
+	for _, kind := range kinds {
+		if err == nil {
+			err = checkIdentifier(kind)
+		}
+	}
+	return newTransactionFilter(transactionTransactionKindIn, append([]string{}, kinds...), err)
+}
+
+// And combines a FromAddress and a ToAddress filter into a FromAndToAddress filter, the node
+// doesn't combine the other filters.
+func (f TransactionFilter) And(other TransactionFilter) TransactionFilter {
+	if err := errors.Join(f.err, other.err); err != nil {
+		return TransactionFilter{variant: transactionFromAndToAddress, err: err}
+	}
+	switch {
+	case f.variant == transactionFromAddress && other.variant == transactionToAddress:
+		return Transaction.FromAndToAddress(f.address, other.address)
+	case f.variant == transactionToAddress && other.variant == transactionFromAddress:
+		return Transaction.FromAndToAddress(other.address, f.address)
+	}
+	return TransactionFilter{variant: transactionFromAndToAddress, err: fmt.Errorf("can't combine the %s and %s transaction filters, only FromAddress and ToAddress", f.variant, other.variant)}
+}
+
+// Or combines a FromAddress and a ToAddress filter of the same address into a FromOrToAddress
+// filter, the node doesn't combine the other filters.
+func (f TransactionFilter) Or(other TransactionFilter) TransactionFilter {
+	if err := errors.Join(f.err, other.err); err != nil {
+		return TransactionFilter{variant: transactionFromOrToAddress, err: err}
+	}
+	addresses := f.variant == transactionFromAddress && other.variant == transactionToAddress ||
+		f.variant == transactionToAddress && other.variant == transactionFromAddress
+	if addresses && f.address == other.address {
+		return Transaction.FromOrToAddress(f.address)
+	}
+	return TransactionFilter{variant: transactionFromOrToAddress, err: fmt.Errorf("can't combine the %s and %s transaction filters, only FromAddress and ToAddress of the same address", f.variant, other.variant)}
+}
+
+// Build returns the JSON of the filter for suix_subscribeTransaction, or for the
+// suix_queryTransactionBlocks of an indexer, which support all the filters.
+func (f TransactionFilter) Build() (models.TransactionFilter, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.variant == "" {
+		return nil, errors.New("empty transaction filter")
+	}
+	return models.TransactionFilter{f.variant: f.value}, nil
+}
+
+// BuildQuery returns the JSON of the filter for the suix_queryTransactionBlocks of a full node,
+// whose indexes don't support the FromAndToAddress, FromOrToAddress, TransactionKind and
+// TransactionKindIn filters.
+func (f TransactionFilter) BuildQuery() (models.TransactionFilter, error) {
+	filter, err := f.Build()
+	if err != nil {
+		return nil, err
+	}
+	switch f.variant {
+	case transactionFromAndToAddress, transactionFromOrToAddress, transactionTransactionKind, transactionTransactionKindIn:
+		return nil, fmt.Errorf("the %s transaction filter is not supported by the suix_queryTransactionBlocks of a full node", f.variant)
+	}
+	return filter, nil
+}
+
+type fromAndToAddressJSON struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type fromOrToAddressJSON struct {
+	Addr string `json:"addr"`
+}
@@ -6,12 +6,14 @@ toolchain go1.24.4
 
 require (
 	github.com/btcsuite/btcutil v1.0.2
+	github.com/consensys/gnark-crypto v0.19.0
 	github.com/cosmos/go-bip39 v1.0.0
-	github.com/fardream/go-bcs v0.7.0
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.0
 	github.com/go-playground/validator/v10 v10.12.0
 	github.com/golang/protobuf v1.5.4
 	github.com/google/go-cmp v0.7.0
 	github.com/gorilla/websocket v1.5.0
+	github.com/iden3/go-iden3-crypto v0.0.17
 	github.com/jinzhu/copier v0.4.0
 	github.com/machinebox/graphql v0.2.2
 	github.com/mr-tron/base58 v1.2.0
@@ -23,9 +25,11 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7
 	google.golang.org/grpc v1.75.0
 	google.golang.org/protobuf v1.36.8
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/bits-and-blooms/bitset v1.20.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
@@ -38,5 +42,4 @@ require (
 	golang.org/x/net v0.47.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
 	golang.org/x/text v0.32.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
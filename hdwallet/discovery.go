@@ -0,0 +1,84 @@
+package hdwallet
+
+import (
+	"context"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// DefaultGapLimit is the number of consecutive unused accounts after which discovery stops, as in BIP-44.
+const DefaultGapLimit = 20
+
+// TransactionQuerier is the part of the Sui client used by account discovery, sui.ISuiAPI implements it.
+type TransactionQuerier interface {
+	SuiXQueryTransactionBlocks(ctx context.Context, req models.SuiXQueryTransactionBlocksRequest) (models.SuiXQueryTransactionBlocksResponse, error)
+}
+
+// DiscoverOptions configures account discovery.
+type DiscoverOptions struct {
+	// Schemes to discover accounts for, all the mnemonic based schemes by default.
+	Schemes []scheme.SignatureScheme
+	// GapLimit is the number of consecutive unused accounts that ends discovery, DefaultGapLimit by default.
+	GapLimit uint32
+}
+
+// DiscoverAccounts derives the accounts of every scheme by increasing index, until GapLimit
+// consecutive accounts have neither sent nor received a transaction, and returns the used ones.
+func (w *Wallet) DiscoverAccounts(ctx context.Context, client TransactionQuerier, options *DiscoverOptions) ([]*Account, error) {
+	schemes := []scheme.SignatureScheme{scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1}
+	gapLimit := uint32(DefaultGapLimit)
+	if options != nil {
+		if len(options.Schemes) > 0 {
+			schemes = options.Schemes
+		}
+		if options.GapLimit > 0 {
+			gapLimit = options.GapLimit
+		}
+	}
+
+	var accounts []*Account
+	for _, signatureScheme := range schemes {
+		for index, gap := uint32(0), uint32(0); gap < gapLimit; index++ {
+			account, err := w.DeriveAccount(signatureScheme, index)
+			if err != nil {
+				return nil, err
+			}
+			used, err := IsAddressUsed(ctx, client, account.Address)
+			if err != nil {
+				return nil, err
+			}
+			if !used {
+				gap++
+				continue
+			}
+			gap = 0
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts, nil
+}
+
+// IsAddressUsed reports whether the address has sent or received at least one transaction.
+func IsAddressUsed(ctx context.Context, client TransactionQuerier, address string) (bool, error) {
+	for _, filter := range []models.TransactionFilter{
+		{"FromAddress": address},
+		{"ToAddress": address},
+	} {
+		rsp, err := client.SuiXQueryTransactionBlocks(ctx, models.SuiXQueryTransactionBlocksRequest{
+			SuiTransactionBlockResponseQuery: models.SuiTransactionBlockResponseQuery{
+				TransactionFilter: filter,
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(rsp.Data) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,139 @@
+// Package hdwallet derives the accounts of a BIP-39 mnemonic for every signature scheme, using
+// the derivation paths of the other Sui wallets and SDKs, and discovers the accounts in use.
+package hdwallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/go-bip39"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+const (
+	// Entropy128 gives a 12 words mnemonic, Entropy256 a 24 words one.
+	Entropy128 = 128
+	Entropy160 = 160
+	Entropy192 = 192
+	Entropy224 = 224
+	Entropy256 = 256
+)
+
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// GenerateMnemonic generates a mnemonic from random entropy of the given size in bits, one of
+// 128, 160, 192, 224 or 256.
+func GenerateMnemonic(entropyBits int) (string, error) {
+	entropy, err := bip39.NewEntropy(entropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// MnemonicFromEntropy returns the mnemonic encoding the entropy.
+func MnemonicFromEntropy(entropy []byte) (string, error) {
+	return bip39.NewMnemonic(entropy)
+}
+
+// DerivationPath returns the path of account for the scheme:
+//   - ED25519:   m/44'/784'/{account}'/0'/0'
+//   - Secp256k1: m/54'/784'/{account}'/0/0
+//   - Secp256r1: m/74'/784'/{account}'/0/0
+func DerivationPath(signatureScheme scheme.SignatureScheme, account uint32) (string, error) {
+	switch signatureScheme {
+	case scheme.ED25519:
+		return fmt.Sprintf("m/44'/784'/%d'/0'/0'", account), nil
+	case scheme.Secp256k1:
+		return fmt.Sprintf("m/54'/784'/%d'/0/0", account), nil
+	case scheme.Secp256r1:
+		return fmt.Sprintf("m/74'/784'/%d'/0/0", account), nil
+	default:
+		return "", fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+}
+
+// Wallet derives signers from a mnemonic.
+type Wallet struct {
+	mnemonic string
+}
+
+// NewWallet checks the mnemonic and returns a wallet for it.
+func NewWallet(mnemonic string) (*Wallet, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	return &Wallet{mnemonic: mnemonic}, nil
+}
+
+// Mnemonic returns the mnemonic of the wallet.
+func (w *Wallet) Mnemonic() string {
+	return w.mnemonic
+}
+
+// Account is a derived account of a wallet.
+type Account struct {
+	Scheme  scheme.SignatureScheme
+	Index   uint32
+	Path    string
+	Address string
+	Signer  models.Signer
+}
+
+// DeriveAccount derives account index for the scheme.
+func (w *Wallet) DeriveAccount(signatureScheme scheme.SignatureScheme, index uint32) (*Account, error) {
+	path, err := DerivationPath(signatureScheme, index)
+	if err != nil {
+		return nil, err
+	}
+	s, err := w.DeriveSignerForPath(signatureScheme, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		Scheme:  signatureScheme,
+		Index:   index,
+		Path:    path,
+		Address: s.ToSuiAddress(),
+		Signer:  s,
+	}, nil
+}
+
+// DeriveSigner derives the signer of account index for the scheme.
+func (w *Wallet) DeriveSigner(signatureScheme scheme.SignatureScheme, index uint32) (models.Signer, error) {
+	account, err := w.DeriveAccount(signatureScheme, index)
+	if err != nil {
+		return nil, err
+	}
+	return account.Signer, nil
+}
+
+// DeriveSignerForPath derives the signer of a custom path for the scheme.
+func (w *Wallet) DeriveSignerForPath(signatureScheme scheme.SignatureScheme, path string) (models.Signer, error) {
+	switch signatureScheme {
+	case scheme.ED25519:
+		s, err := signer.NewSignerWithMnemonicAndPath(w.mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case scheme.Secp256k1:
+		s, err := signer.NewSecp256k1SignerWithMnemonicAndPath(w.mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case scheme.Secp256r1:
+		s, err := signer.NewSecp256r1SignerWithMnemonicAndPath(w.mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+}
@@ -0,0 +1,132 @@
+package hdwallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/signer"
+	"github.com/block-vision/sui-go-sdk/sui"
+)
+
+const testMnemonic = "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm"
+
+var _ TransactionQuerier = sui.ISuiAPI(nil)
+
+func TestGenerateMnemonic(t *testing.T) {
+	for bits, words := range map[int]int{Entropy128: 12, Entropy160: 15, Entropy192: 18, Entropy224: 21, Entropy256: 24} {
+		mnemonic, err := GenerateMnemonic(bits)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(mnemonic), words)
+		_, err = NewWallet(mnemonic)
+		require.NoError(t, err)
+	}
+
+	_, err := GenerateMnemonic(100)
+	require.Error(t, err)
+	_, err = NewWallet("film crazy soon")
+	require.ErrorIs(t, err, ErrInvalidMnemonic)
+
+	mnemonic, err := MnemonicFromEntropy(make([]byte, 16))
+	require.NoError(t, err)
+	require.Equal(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", mnemonic)
+}
+
+func TestDeriveAccount(t *testing.T) {
+	wallet, err := NewWallet(testMnemonic)
+	require.NoError(t, err)
+	r1Wallet, err := NewWallet("act wing dilemma glory episode region allow mad tourist humble muffin oblige")
+	require.NoError(t, err)
+
+	edSigner, err := signer.NewSignertWithMnemonic(testMnemonic)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		wallet      *Wallet
+		scheme      scheme.SignatureScheme
+		index       uint32
+		wantPath    string
+		wantAddress string
+	}{
+		{name: "ed25519", wallet: wallet, scheme: scheme.ED25519, wantPath: "m/44'/784'/0'/0'/0'", wantAddress: edSigner.Address},
+		{name: "secp256k1", wallet: wallet, scheme: scheme.Secp256k1, wantPath: "m/54'/784'/0'/0/0", wantAddress: "0x9e8f732575cc5386f8df3c784cd3ed1b53ce538da79926b2ad54dcc1197d2532"},
+		{name: "secp256r1", wallet: r1Wallet, scheme: scheme.Secp256r1, wantPath: "m/74'/784'/0'/0/0", wantAddress: "0x4a822457f1970468d38dae8e63fb60eefdaa497d74d781f581ea2d137ec36f3a"},
+		{name: "ed25519 account 3", wallet: wallet, scheme: scheme.ED25519, index: 3, wantPath: "m/44'/784'/3'/0'/0'"},
+		{name: "secp256k1 account 3", wallet: wallet, scheme: scheme.Secp256k1, index: 3, wantPath: "m/54'/784'/3'/0/0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := tt.wallet.DeriveAccount(tt.scheme, tt.index)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPath, account.Path)
+			require.Equal(t, tt.scheme, account.Signer.GetKeyScheme())
+			if tt.wantAddress != "" {
+				require.Equal(t, tt.wantAddress, account.Address)
+			}
+			if tt.index > 0 {
+				first, err := tt.wallet.DeriveAccount(tt.scheme, 0)
+				require.NoError(t, err)
+				require.NotEqual(t, first.Address, account.Address)
+			}
+		})
+	}
+
+	_, err = wallet.DeriveAccount(scheme.MultiSig, 0)
+	require.Error(t, err)
+	for _, signatureScheme := range []scheme.SignatureScheme{scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1} {
+		s, err := wallet.DeriveSignerForPath(signatureScheme, "m/invalid")
+		require.Error(t, err)
+		require.True(t, s == nil, signatureScheme)
+	}
+}
+
+type fakeQuerier struct {
+	used map[string]bool
+}
+
+func (f *fakeQuerier) SuiXQueryTransactionBlocks(_ context.Context, req models.SuiXQueryTransactionBlocksRequest) (models.SuiXQueryTransactionBlocksResponse, error) {
+	var rsp models.SuiXQueryTransactionBlocksResponse
+	for _, address := range req.SuiTransactionBlockResponseQuery.TransactionFilter {
+		if f.used[address.(string)] {
+			rsp.Data = append(rsp.Data, models.SuiTransactionBlockResponse{})
+		}
+	}
+	return rsp, nil
+}
+
+func TestDiscoverAccounts(t *testing.T) {
+	wallet, err := NewWallet(testMnemonic)
+	require.NoError(t, err)
+
+	address := func(s scheme.SignatureScheme, index uint32) string {
+		account, err := wallet.DeriveAccount(s, index)
+		require.NoError(t, err)
+		return account.Address
+	}
+	querier := &fakeQuerier{used: map[string]bool{
+		address(scheme.ED25519, 0):   true,
+		address(scheme.ED25519, 2):   true,
+		address(scheme.ED25519, 6):   true, // beyond the gap
+		address(scheme.Secp256k1, 1): true,
+	}}
+
+	accounts, err := wallet.DiscoverAccounts(context.Background(), querier, &DiscoverOptions{GapLimit: 3})
+	require.NoError(t, err)
+	var found []string
+	for _, account := range accounts {
+		found = append(found, account.Path)
+	}
+	require.Equal(t, []string{"m/44'/784'/0'/0'/0'", "m/44'/784'/2'/0'/0'", "m/54'/784'/1'/0/0"}, found)
+
+	accounts, err = wallet.DiscoverAccounts(context.Background(), querier, &DiscoverOptions{
+		Schemes:  []scheme.SignatureScheme{scheme.Secp256r1},
+		GapLimit: 2,
+	})
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
@@ -4,17 +4,19 @@ import (
 	"bytes"
 	"crypto/ed25519"
 	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 
 	"github.com/machinebox/graphql"
 	"golang.org/x/crypto/blake2b"
 
 	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 )
 
+const PublicKeySize = ed25519.PublicKeySize
+
 type Ed25519PublicKey struct {
 	signature []byte
 }
@@ -26,14 +28,52 @@ func NewEd25519PublicKey(signature []byte) *Ed25519PublicKey {
 }
 
 func (e *Ed25519PublicKey) ToSuiAddress() string {
-	panic("not implemented")
+	return Ed25519PublicKeyToSuiAddress(e.signature)
+}
+
+// ToRawBytes returns the 32 bytes public key.
+func (e *Ed25519PublicKey) ToRawBytes() []byte {
+	return e.signature
+}
+
+func (e *Ed25519PublicKey) Scheme() scheme.SignatureScheme {
+	return scheme.ED25519
 }
 
+func (e *Ed25519PublicKey) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.ED25519]
+}
+
+func (e *Ed25519PublicKey) ToSuiBytes() []byte {
+	return models.ToSuiBytes(e.Flag(), e.signature)
+}
+
+func (e *Ed25519PublicKey) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(e.ToSuiBytes())
+}
+
+// VerifyPersonalMessage verifies the raw 64 bytes signature over the personal message.
 func (e *Ed25519PublicKey) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
-	b64Message := base64.StdEncoding.EncodeToString([]byte(message))
-	b64Signature := base64.StdEncoding.EncodeToString([]byte(signature))
-	_, pass, err := VerifyMessage(b64Message, b64Signature, constant.PersonalMessageIntentScope)
-	return pass, err
+	intentMessage := models.NewPersonalMessageIntentMessage(message)
+	return e.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+}
+
+// VerifyTransaction verifies the raw 64 bytes signature over the transaction data bytes.
+func (e *Ed25519PublicKey) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	return e.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+}
+
+// VerifyWithIntent verifies the raw 64 bytes signature over the blake2b digest of the intent message.
+func (e *Ed25519PublicKey) VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	if len(e.signature) != ed25519.PublicKeySize {
+		return false, fmt.Errorf("invalid ed25519 public key size: %d", len(e.signature))
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return false, fmt.Errorf("invalid ed25519 signature size: %d", len(signature))
+	}
+
+	digest := blake2b.Sum256(models.NewMessageWithIntent(bytes, scope))
+	return ed25519.Verify(e.signature, digest[:], signature), nil
 }
 
 func VerifyMessage(message, signature string, scope constant.IntentScope) (signer string, pass bool, err error) {
@@ -62,9 +102,5 @@ func VerifyMessage(message, signature string, scope constant.IntentScope) (signe
 }
 
 func Ed25519PublicKeyToSuiAddress(pubKey []byte) string {
-	newPubkey := []byte{byte(models.SigFlagEd25519)}
-	newPubkey = append(newPubkey, pubKey...)
-
-	addrBytes := blake2b.Sum256(newPubkey)
-	return fmt.Sprintf("0x%s", hex.EncodeToString(addrBytes[:])[:64])
+	return models.PublicKeyToSuiAddress(byte(models.SigFlagEd25519), pubKey)
 }
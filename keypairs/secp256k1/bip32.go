@@ -0,0 +1,118 @@
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	FirstHardenedIndex = uint32(0x80000000)
+	seedModifier       = "Bitcoin seed"
+)
+
+var (
+	ErrInvalidPath = errors.New("invalid derivation path")
+	ErrInvalidKey  = errors.New("invalid derived key")
+
+	pathRegex = regexp.MustCompile(`^m(\/[0-9]+'?)+$`)
+)
+
+// Key is an extended private key.
+type Key struct {
+	Key       []byte
+	ChainCode []byte
+}
+
+// DeriveForPath derives the private key for a BIP-32 path from a seed on the secp256k1 curve.
+// Unlike ed25519, both hardened (`'`) and non-hardened segments are supported.
+func DeriveForPath(path string, seed []byte) (*Key, error) {
+	if !pathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	key, err := NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(path, "/")
+	for _, segment := range segments[1:] {
+		i64, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 31)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+
+		i := uint32(i64)
+		if strings.HasSuffix(segment, "'") {
+			i += FirstHardenedIndex
+		}
+		key, err = key.Derive(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// NewMasterKey generates a new master key from seed.
+func NewMasterKey(seed []byte) (*Key, error) {
+	hash := hmac.New(sha512.New, []byte(seedModifier))
+	_, err := hash.Write(seed)
+	if err != nil {
+		return nil, err
+	}
+	sum := hash.Sum(nil)
+
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(sum[:32]); overflow || k.IsZero() {
+		return nil, ErrInvalidKey
+	}
+
+	return &Key{
+		Key:       sum[:32],
+		ChainCode: sum[32:],
+	}, nil
+}
+
+// Derive derives the child key at index i, hardened when i >= FirstHardenedIndex.
+func (k *Key) Derive(i uint32) (*Key, error) {
+	var data []byte
+	if i >= FirstHardenedIndex {
+		data = append([]byte{0x0}, k.Key...)
+	} else {
+		data = secp256k1.PrivKeyFromBytes(k.Key).PubKey().SerializeCompressed()
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+
+	hash := hmac.New(sha512.New, k.ChainCode)
+	_, err := hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	sum := hash.Sum(nil)
+
+	// child = parse256(IL) + k (mod n)
+	var il, parent secp256k1.ModNScalar
+	if overflow := il.SetByteSlice(sum[:32]); overflow {
+		return nil, ErrInvalidKey
+	}
+	parent.SetByteSlice(k.Key)
+	il.Add(&parent)
+	if il.IsZero() {
+		return nil, ErrInvalidKey
+	}
+	childKey := il.Bytes()
+
+	return &Key{
+		Key:       childKey[:],
+		ChainCode: sum[32:],
+	}, nil
+}
@@ -0,0 +1,94 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const (
+	SecretKeySize  = 32
+	DefaultPath    = `m/54'/784'/0'/0/0`
+	derivationPath = `^m\/54'\/784'\/[0-9]+'\/[0-9]+\/[0-9]+$`
+)
+
+var derivationPathRegex = regexp.MustCompile(derivationPath)
+
+type Secp256k1Keypair struct {
+	priKey *secp256k1.PrivateKey
+}
+
+// GenerateSecp256k1Keypair generates a random keypair.
+func GenerateSecp256k1Keypair() (*Secp256k1Keypair, error) {
+	priKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Secp256k1Keypair{priKey: priKey}, nil
+}
+
+// NewSecp256k1Keypair creates a keypair from a 32 bytes secret key.
+func NewSecp256k1Keypair(secretKey []byte) (*Secp256k1Keypair, error) {
+	if len(secretKey) != SecretKeySize {
+		return nil, fmt.Errorf("invalid secp256k1 secret key size: %d", len(secretKey))
+	}
+
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(secretKey); overflow || k.IsZero() {
+		return nil, fmt.Errorf("invalid secp256k1 secret key")
+	}
+
+	return &Secp256k1Keypair{priKey: secp256k1.NewPrivateKey(&k)}, nil
+}
+
+// DeriveSecp256k1Keypair derives a keypair from a mnemonic on a `m/54'/784'/{account}'/{change}/{index}` path,
+// the default path is used when path is empty.
+func DeriveSecp256k1Keypair(mnemonic string, path string) (*Secp256k1Keypair, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+	if !derivationPathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	key, err := DeriveForPath(path, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSecp256k1Keypair(key.Key)
+}
+
+// SecretKey returns the 32 bytes secret key.
+func (k *Secp256k1Keypair) SecretKey() []byte {
+	return k.priKey.Serialize()
+}
+
+func (k *Secp256k1Keypair) PublicKey() *Secp256k1PublicKey {
+	return NewSecp256k1PublicKey(k.priKey.PubKey().SerializeCompressed())
+}
+
+// Sign signs the sha256 hash of the message and returns the 64 bytes `r || s` signature.
+// The signature is deterministic (RFC6979) and s is normalized to the lower half of the order.
+func (k *Secp256k1Keypair) Sign(message []byte) []byte {
+	hash := sha256.Sum256(message)
+	sig := ecdsa.Sign(k.priKey, hash[:])
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+
+	return append(rBytes[:], sBytes[:]...)
+}
+
+// Zero clears the secret key from memory, the keypair must not be used afterwards.
+func (k *Secp256k1Keypair) Zero() {
+	k.priKey.Zero()
+}
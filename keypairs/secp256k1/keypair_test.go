@@ -0,0 +1,65 @@
+package secp256k1
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveForPath(t *testing.T) {
+	// BIP-32 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			key, err := DeriveForPath(tt.path, seed)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, hex.EncodeToString(key.Key))
+		})
+	}
+
+	_, err := DeriveForPath("m/a", seed)
+	require.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestDeriveSecp256k1Keypair(t *testing.T) {
+	mnemonic := "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm"
+
+	kp, err := DeriveSecp256k1Keypair(mnemonic, "")
+	require.NoError(t, err)
+	require.Equal(t, "AQK9lbNnoth4GgiL3LAFQGemymF4Q30U5ReN9qfFp+JbCw==",
+		base64.StdEncoding.EncodeToString(append([]byte{0x01}, kp.PublicKey().ToRawBytes()...)))
+	require.Equal(t, "0x9e8f732575cc5386f8df3c784cd3ed1b53ce538da79926b2ad54dcc1197d2532", kp.PublicKey().ToSuiAddress())
+
+	_, err = DeriveSecp256k1Keypair(mnemonic, "m/44'/784'/0'/0'/0'")
+	require.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestSign(t *testing.T) {
+	kp, err := GenerateSecp256k1Keypair()
+	require.NoError(t, err)
+
+	restored, err := NewSecp256k1Keypair(kp.SecretKey())
+	require.NoError(t, err)
+	require.Equal(t, kp.PublicKey().ToRawBytes(), restored.PublicKey().ToRawBytes())
+
+	message := []byte("hello")
+	signature := kp.Sign(message)
+	require.Len(t, signature, SignatureSize)
+
+	pass, err := kp.PublicKey().Verify(message, signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	pass, err = kp.PublicKey().Verify([]byte("hello!"), signature)
+	require.NoError(t, err)
+	require.False(t, pass)
+}
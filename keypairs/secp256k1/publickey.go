@@ -0,0 +1,105 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/machinebox/graphql"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+const (
+	PublicKeySize = 33
+	SignatureSize = 64
+)
+
+type Secp256k1PublicKey struct {
+	data []byte
+}
+
+// NewSecp256k1PublicKey wraps a 33 bytes compressed public key.
+func NewSecp256k1PublicKey(data []byte) *Secp256k1PublicKey {
+	return &Secp256k1PublicKey{
+		data: data,
+	}
+}
+
+// ToRawBytes returns the 33 bytes compressed public key.
+func (p *Secp256k1PublicKey) ToRawBytes() []byte {
+	return p.data
+}
+
+func (p *Secp256k1PublicKey) Scheme() scheme.SignatureScheme {
+	return scheme.Secp256k1
+}
+
+func (p *Secp256k1PublicKey) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.Secp256k1]
+}
+
+func (p *Secp256k1PublicKey) ToSuiBytes() []byte {
+	return models.ToSuiBytes(p.Flag(), p.data)
+}
+
+func (p *Secp256k1PublicKey) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(p.ToSuiBytes())
+}
+
+func (p *Secp256k1PublicKey) ToSuiAddress() string {
+	return Secp256k1PublicKeyToSuiAddress(p.data)
+}
+
+// VerifyPersonalMessage verifies the raw 64 bytes signature over the personal message.
+func (p *Secp256k1PublicKey) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
+	intentMessage := models.NewPersonalMessageIntentMessage(message)
+	return p.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+}
+
+// VerifyTransaction verifies the raw 64 bytes signature over the transaction data bytes.
+func (p *Secp256k1PublicKey) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	return p.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+}
+
+// VerifyWithIntent verifies the raw 64 bytes signature over the blake2b digest of the intent message.
+func (p *Secp256k1PublicKey) VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	digest := blake2b.Sum256(models.NewMessageWithIntent(bytes, scope))
+	return p.Verify(digest[:], signature)
+}
+
+// Verify verifies the raw 64 bytes `r || s` signature over the sha256 hash of the message.
+// Only signatures with a low s are accepted.
+func (p *Secp256k1PublicKey) Verify(message []byte, signature []byte) (bool, error) {
+	if len(p.data) != PublicKeySize {
+		return false, fmt.Errorf("invalid secp256k1 public key size: %d", len(p.data))
+	}
+	if len(signature) != SignatureSize {
+		return false, fmt.Errorf("invalid secp256k1 signature size: %d", len(signature))
+	}
+
+	pubKey, err := secp256k1.ParsePubKey(p.data)
+	if err != nil {
+		return false, fmt.Errorf("invalid secp256k1 public key: %v", err)
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return false, nil
+	}
+	if overflow := s.SetByteSlice(signature[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false, nil
+	}
+
+	hash := sha256.Sum256(message)
+	return ecdsa.NewSignature(&r, &s).Verify(hash[:], pubKey), nil
+}
+
+func Secp256k1PublicKeyToSuiAddress(pubKey []byte) string {
+	return models.PublicKeyToSuiAddress(scheme.SignatureSchemeToFlag[scheme.Secp256k1], pubKey)
+}
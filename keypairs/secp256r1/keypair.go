@@ -0,0 +1,128 @@
+package secp256r1
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/cosmos/go-bip39"
+
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256k1"
+)
+
+const (
+	SecretKeySize  = 32
+	DefaultPath    = `m/74'/784'/0'/0/0`
+	derivationPath = `^m\/74'\/784'\/[0-9]+'\/[0-9]+\/[0-9]+$`
+)
+
+var derivationPathRegex = regexp.MustCompile(derivationPath)
+
+type Secp256r1Keypair struct {
+	priKey *ecdsa.PrivateKey
+}
+
+// GenerateSecp256r1Keypair generates a random keypair.
+func GenerateSecp256r1Keypair() (*Secp256r1Keypair, error) {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Secp256r1Keypair{priKey: priKey}, nil
+}
+
+// NewSecp256r1Keypair creates a keypair from a 32 bytes secret key.
+func NewSecp256r1Keypair(secretKey []byte) (*Secp256r1Keypair, error) {
+	if len(secretKey) != SecretKeySize {
+		return nil, fmt.Errorf("invalid secp256r1 secret key size: %d", len(secretKey))
+	}
+
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(secretKey)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid secp256r1 secret key")
+	}
+
+	priKey := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve},
+		D:         d,
+	}
+	priKey.PublicKey.X, priKey.PublicKey.Y = curve.ScalarBaseMult(secretKey)
+
+	return &Secp256r1Keypair{priKey: priKey}, nil
+}
+
+// DeriveSecp256r1Keypair derives a keypair from a mnemonic on a `m/74'/784'/{account}'/{change}/{index}` path,
+// the default path is used when path is empty. As in the other Sui SDKs, the secret key is derived with
+// BIP-32 over secp256k1 and then used as a secp256r1 scalar.
+func DeriveSecp256r1Keypair(mnemonic string, path string) (*Secp256r1Keypair, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+	if !derivationPathRegex.MatchString(path) {
+		return nil, secp256k1.ErrInvalidPath
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	key, err := secp256k1.DeriveForPath(path, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSecp256r1Keypair(key.Key)
+}
+
+// SecretKey returns the 32 bytes secret key.
+func (k *Secp256r1Keypair) SecretKey() []byte {
+	return k.priKey.D.FillBytes(make([]byte, SecretKeySize))
+}
+
+func (k *Secp256r1Keypair) PublicKey() *Secp256r1PublicKey {
+	return NewSecp256r1PublicKey(elliptic.MarshalCompressed(elliptic.P256(), k.priKey.X, k.priKey.Y))
+}
+
+// Sign signs the sha256 hash of the message and returns the 64 bytes `r || s` signature.
+// The signature is deterministic (RFC6979) and s is normalized to the lower half of the order.
+func (k *Secp256r1Keypair) Sign(message []byte) ([]byte, error) {
+	hash := sha256.Sum256(message)
+	der, err := k.priKey.Sign(nil, hash[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, err
+	}
+
+	n := elliptic.P256().Params().N
+	if sig.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sig.S.Sub(n, sig.S)
+	}
+
+	signature := make([]byte, SignatureSize)
+	sig.R.FillBytes(signature[:32])
+	sig.S.FillBytes(signature[32:])
+	return signature, nil
+}
+
+// Zero clears the secret key from memory, the keypair must not be used afterwards.
+func (k *Secp256r1Keypair) Zero() {
+	words := k.priKey.D.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	k.priKey.D.SetInt64(0)
+}
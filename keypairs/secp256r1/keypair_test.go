@@ -0,0 +1,42 @@
+package secp256r1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSecp256r1Keypair(t *testing.T) {
+	mnemonic := "act wing dilemma glory episode region allow mad tourist humble muffin oblige"
+
+	kp, err := DeriveSecp256r1Keypair(mnemonic, "")
+	require.NoError(t, err)
+	require.Equal(t, "0x4a822457f1970468d38dae8e63fb60eefdaa497d74d781f581ea2d137ec36f3a", kp.PublicKey().ToSuiAddress())
+
+	_, err = DeriveSecp256r1Keypair(mnemonic, "m/54'/784'/0'/0/0")
+	require.Error(t, err)
+}
+
+func TestSign(t *testing.T) {
+	kp, err := GenerateSecp256r1Keypair()
+	require.NoError(t, err)
+
+	restored, err := NewSecp256r1Keypair(kp.SecretKey())
+	require.NoError(t, err)
+	require.Equal(t, kp.PublicKey().ToRawBytes(), restored.PublicKey().ToRawBytes())
+
+	message := []byte("hello")
+	signature, err := kp.Sign(message)
+	require.NoError(t, err)
+	again, err := kp.Sign(message)
+	require.NoError(t, err)
+	require.Equal(t, signature, again)
+
+	pass, err := kp.PublicKey().Verify(message, signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	pass, err = kp.PublicKey().Verify([]byte("hello!"), signature)
+	require.NoError(t, err)
+	require.False(t, pass)
+}
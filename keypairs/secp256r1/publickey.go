@@ -0,0 +1,113 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/machinebox/graphql"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+const (
+	PublicKeySize = 33
+	SignatureSize = 64
+)
+
+type Secp256r1PublicKey struct {
+	data []byte
+}
+
+// NewSecp256r1PublicKey wraps a 33 bytes compressed public key.
+func NewSecp256r1PublicKey(data []byte) *Secp256r1PublicKey {
+	return &Secp256r1PublicKey{
+		data: data,
+	}
+}
+
+// ToRawBytes returns the 33 bytes compressed public key.
+func (p *Secp256r1PublicKey) ToRawBytes() []byte {
+	return p.data
+}
+
+func (p *Secp256r1PublicKey) Scheme() scheme.SignatureScheme {
+	return scheme.Secp256r1
+}
+
+func (p *Secp256r1PublicKey) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.Secp256r1]
+}
+
+func (p *Secp256r1PublicKey) ToSuiBytes() []byte {
+	return models.ToSuiBytes(p.Flag(), p.data)
+}
+
+func (p *Secp256r1PublicKey) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(p.ToSuiBytes())
+}
+
+func (p *Secp256r1PublicKey) ToSuiAddress() string {
+	return Secp256r1PublicKeyToSuiAddress(p.data)
+}
+
+// VerifyPersonalMessage verifies the raw 64 bytes signature over the personal message.
+func (p *Secp256r1PublicKey) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
+	intentMessage := models.NewPersonalMessageIntentMessage(message)
+	return p.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+}
+
+// VerifyTransaction verifies the raw 64 bytes signature over the transaction data bytes.
+func (p *Secp256r1PublicKey) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	return p.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+}
+
+// VerifyWithIntent verifies the raw 64 bytes signature over the blake2b digest of the intent message.
+func (p *Secp256r1PublicKey) VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	digest := blake2b.Sum256(models.NewMessageWithIntent(bytes, scope))
+	return p.Verify(digest[:], signature)
+}
+
+// Verify verifies the raw 64 bytes `r || s` signature over the sha256 hash of the message.
+// Only signatures with a low s are accepted.
+func (p *Secp256r1PublicKey) Verify(message []byte, signature []byte) (bool, error) {
+	pubKey, err := p.ecdsaPublicKey()
+	if err != nil {
+		return false, err
+	}
+	if len(signature) != SignatureSize {
+		return false, fmt.Errorf("invalid secp256r1 signature size: %d", len(signature))
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		return false, nil
+	}
+
+	hash := sha256.Sum256(message)
+	return ecdsa.Verify(pubKey, hash[:], r, s), nil
+}
+
+func (p *Secp256r1PublicKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	if len(p.data) != PublicKeySize {
+		return nil, fmt.Errorf("invalid secp256r1 public key size: %d", len(p.data))
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), p.data)
+	if x == nil {
+		return nil, fmt.Errorf("invalid secp256r1 public key")
+	}
+
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+func Secp256r1PublicKeyToSuiAddress(pubKey []byte) string {
+	return models.PublicKeyToSuiAddress(scheme.SignatureSchemeToFlag[scheme.Secp256r1], pubKey)
+}
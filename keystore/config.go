@@ -0,0 +1,151 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+var (
+	ErrNoActiveEnv     = errors.New("no active env in client config")
+	ErrNoActiveAddress = errors.New("no active address in client config")
+	ErrEnvNotFound     = errors.New("env not in client config")
+	ErrNoKeystoreFile  = errors.New("client config has no keystore file")
+)
+
+// KeystoreConfig is the keystore location of the client config, only file keystores are supported.
+type KeystoreConfig struct {
+	File string `yaml:"File"`
+}
+
+// SuiEnv is a network of the client config.
+type SuiEnv struct {
+	Alias     string  `yaml:"alias"`
+	Rpc       string  `yaml:"rpc"`
+	Ws        *string `yaml:"ws"`
+	BasicAuth *string `yaml:"basic_auth"`
+	ChainId   *string `yaml:"chain_id,omitempty"`
+}
+
+// ClientConfig is the `client.yaml` file of the Sui CLI.
+type ClientConfig struct {
+	Keystore      KeystoreConfig `yaml:"keystore"`
+	ExternalKeys  interface{}    `yaml:"external_keys"`
+	Envs          []*SuiEnv      `yaml:"envs"`
+	ActiveEnv     *string        `yaml:"active_env"`
+	ActiveAddress *string        `yaml:"active_address"`
+
+	path string
+}
+
+// LoadClientConfig loads the client config at path.
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config ClientConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse client config %s: %v", path, err)
+	}
+	config.path = path
+
+	return &config, nil
+}
+
+// LoadDefaultClientConfig loads `~/.sui/sui_config/client.yaml`.
+func LoadDefaultClientConfig() (*ClientConfig, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return LoadClientConfig(filepath.Join(dir, ClientConfigFileName))
+}
+
+// Save writes the client config to path, or to the path it was loaded from when path is empty.
+func (c *ClientConfig) Save(path string) error {
+	if path == "" {
+		path = c.path
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, append([]byte("---\n"), data...), 0o600); err != nil {
+		return err
+	}
+	c.path = path
+	return nil
+}
+
+// Env returns the env named alias.
+func (c *ClientConfig) Env(alias string) (*SuiEnv, error) {
+	for _, env := range c.Envs {
+		if env.Alias == alias {
+			return env, nil
+		}
+	}
+	return nil, ErrEnvNotFound
+}
+
+// GetActiveEnv returns the active env.
+func (c *ClientConfig) GetActiveEnv() (*SuiEnv, error) {
+	if c.ActiveEnv == nil {
+		return nil, ErrNoActiveEnv
+	}
+	return c.Env(*c.ActiveEnv)
+}
+
+// ActiveRpc returns the RPC URL of the active env.
+func (c *ClientConfig) ActiveRpc() (string, error) {
+	env, err := c.GetActiveEnv()
+	if err != nil {
+		return "", err
+	}
+	return env.Rpc, nil
+}
+
+// GetActiveAddress returns the active address.
+func (c *ClientConfig) GetActiveAddress() (string, error) {
+	if c.ActiveAddress == nil || *c.ActiveAddress == "" {
+		return "", ErrNoActiveAddress
+	}
+	return *c.ActiveAddress, nil
+}
+
+// LoadKeystore loads the keystore referenced by the config. A relative path is resolved from
+// the directory of the config file.
+func (c *ClientConfig) LoadKeystore() (*Keystore, error) {
+	if c.Keystore.File == "" {
+		return nil, ErrNoKeystoreFile
+	}
+
+	path := c.Keystore.File
+	if !filepath.IsAbs(path) && c.path != "" {
+		path = filepath.Join(filepath.Dir(c.path), path)
+	}
+	return Load(path)
+}
+
+// ActiveSigner returns the signer of the active address from the config keystore.
+func (c *ClientConfig) ActiveSigner() (models.Signer, error) {
+	address, err := c.GetActiveAddress()
+	if err != nil {
+		return nil, err
+	}
+	ks, err := c.LoadKeystore()
+	if err != nil {
+		return nil, err
+	}
+	return ks.Signer(address)
+}
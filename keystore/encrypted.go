@@ -0,0 +1,428 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	encryptedKeystoreVersion = 1
+
+	KdfScrypt    = "scrypt"
+	KdfArgon2id  = "argon2id"
+	CipherAESGCM = "aes-256-gcm"
+
+	DefaultScryptN       = 1 << 18
+	DefaultScryptR       = 8
+	DefaultScryptP       = 1
+	DefaultArgon2Time    = 3
+	DefaultArgon2Memory  = 64 * 1024
+	DefaultArgon2Threads = 4
+
+	derivedKeySize = 32
+	saltSize       = 32
+)
+
+var (
+	ErrInvalidPassphrase  = errors.New("invalid passphrase or corrupted keystore")
+	ErrUnsupportedKdf     = errors.New("unsupported keystore kdf")
+	ErrUnsupportedCipher  = errors.New("unsupported keystore cipher")
+	ErrUnsupportedVersion = errors.New("unsupported encrypted keystore version")
+)
+
+// EncryptOptions selects the key derivation function protecting an encrypted keystore. The zero
+// value uses scrypt with the default parameters.
+type EncryptOptions struct {
+	// Kdf is KdfScrypt or KdfArgon2id.
+	Kdf string
+
+	ScryptN int
+	ScryptR int
+	ScryptP int
+
+	Argon2Time    uint32
+	Argon2Memory  uint32 // in KiB
+	Argon2Threads uint8
+}
+
+// KdfParams are the key derivation parameters stored in the keystore file.
+type KdfParams struct {
+	Name string `json:"name"`
+	Salt string `json:"salt"`
+
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// CipherParams are the cipher parameters stored in the keystore file.
+type CipherParams struct {
+	Name  string `json:"name"`
+	Nonce string `json:"nonce"`
+}
+
+// encryptedFile is the JSON layout of an encrypted keystore. The addresses are stored in clear
+// so they can be listed without the passphrase, and are authenticated as additional data.
+type encryptedFile struct {
+	Version    int          `json:"version"`
+	Addresses  []string     `json:"addresses"`
+	Kdf        KdfParams    `json:"kdf"`
+	Cipher     CipherParams `json:"cipher"`
+	Ciphertext string       `json:"ciphertext"`
+}
+
+// encryptedContent is the plaintext of an encrypted keystore, in the Sui CLI keystore and aliases
+// formats. The keys are kept in byte slices, base64 encoded in JSON, so they can be zeroed.
+type encryptedContent struct {
+	Keys    [][]byte `json:"keys"`
+	Aliases []Alias  `json:"aliases"`
+}
+
+func (c *encryptedContent) zero() {
+	for _, key := range c.Keys {
+		zero(key)
+	}
+}
+
+// EncryptedKeystore is a keystore file encrypted with AES-256-GCM under a key derived from a
+// passphrase with scrypt or argon2id.
+type EncryptedKeystore struct {
+	path string
+	file encryptedFile
+}
+
+// CreateEncryptedKeystore encrypts the keys of ks under passphrase and writes them to path.
+func CreateEncryptedKeystore(path string, ks *Keystore, passphrase []byte, options *EncryptOptions) (*EncryptedKeystore, error) {
+	kdf, err := newKdfParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	eks := &EncryptedKeystore{path: path}
+	if err := eks.seal(ks, passphrase, kdf); err != nil {
+		return nil, err
+	}
+	return eks, nil
+}
+
+// LoadEncryptedKeystore loads the encrypted keystore at path, it is unlocked with Unlock.
+func LoadEncryptedKeystore(path string) (*EncryptedKeystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse encrypted keystore %s: %v", path, err)
+	}
+	if file.Version != encryptedKeystoreVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	return &EncryptedKeystore{path: path, file: file}, nil
+}
+
+// Path returns the path of the encrypted keystore file.
+func (e *EncryptedKeystore) Path() string {
+	return e.path
+}
+
+// Addresses lists the addresses of the keystore without decrypting it.
+func (e *EncryptedKeystore) Addresses() []string {
+	return append([]string(nil), e.file.Addresses...)
+}
+
+// Unlock decrypts the keystore and returns an in-memory keystore holding its signers. Call
+// Zero on it once the keys are no longer needed.
+func (e *EncryptedKeystore) Unlock(passphrase []byte) (*Keystore, error) {
+	plaintext, err := e.open(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(plaintext)
+
+	var content encryptedContent
+	defer content.zero()
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	aliases := make(map[string]string, len(content.Aliases))
+	for _, alias := range content.Aliases {
+		aliases[alias.PublicKeyBase64] = alias.Alias
+	}
+
+	ks := New("")
+	for _, data := range content.Keys {
+		key, err := decodeKeyBytes(data)
+		if err != nil {
+			ks.Zero()
+			return nil, err
+		}
+		key.Alias = aliases[suiPublicKeyBase64(key.Signer)]
+		if key.Alias == "" {
+			key.Alias = ks.defaultAlias(key.Signer.ToSuiAddress())
+		}
+		ks.keys = append(ks.keys, key)
+	}
+
+	return ks, nil
+}
+
+// Update replaces the keys of the encrypted keystore with those of ks. The passphrase must
+// unlock the current keystore, the keys are encrypted under it again.
+func (e *EncryptedKeystore) Update(ks *Keystore, passphrase []byte) error {
+	plaintext, err := e.open(passphrase)
+	if err != nil {
+		return err
+	}
+	zero(plaintext)
+
+	kdf, err := e.file.Kdf.withNewSalt()
+	if err != nil {
+		return err
+	}
+	return e.seal(ks, passphrase, kdf)
+}
+
+// ChangePassphrase encrypts the keystore under newPassphrase.
+func (e *EncryptedKeystore) ChangePassphrase(oldPassphrase []byte, newPassphrase []byte) error {
+	ks, err := e.Unlock(oldPassphrase)
+	if err != nil {
+		return err
+	}
+	defer ks.Zero()
+
+	kdf, err := e.file.Kdf.withNewSalt()
+	if err != nil {
+		return err
+	}
+	return e.seal(ks, newPassphrase, kdf)
+}
+
+// ExportKey returns the key of address in the Sui CLI keystore format, base64 `flag || secret_key`.
+// The returned string can't be zeroed, use ExportKeyBytes to control the copies of the key.
+func (e *EncryptedKeystore) ExportKey(passphrase []byte, address string) (string, error) {
+	data, err := e.ExportKeyBytes(passphrase, address)
+	if err != nil {
+		return "", err
+	}
+	defer zero(data)
+	return string(data), nil
+}
+
+// ExportKeyBytes returns the key of address in the Sui CLI keystore format, base64
+// `flag || secret_key`, in a byte slice the caller zeroes once the key is no longer needed.
+func (e *EncryptedKeystore) ExportKeyBytes(passphrase []byte, address string) ([]byte, error) {
+	ks, err := e.Unlock(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer ks.Zero()
+
+	key, err := ks.Key(address)
+	if err != nil {
+		return nil, err
+	}
+	data := keyBytes(key)
+	defer zero(data)
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(encoded, data)
+	return encoded, nil
+}
+
+// Export decrypts the keystore and writes it in clear to path, with its aliases file, so it
+// can be used by the Sui CLI.
+func (e *EncryptedKeystore) Export(passphrase []byte, path string) error {
+	ks, err := e.Unlock(passphrase)
+	if err != nil {
+		return err
+	}
+	defer ks.Zero()
+
+	ks.path = path
+	return ks.Save()
+}
+
+func (e *EncryptedKeystore) seal(ks *Keystore, passphrase []byte, kdf KdfParams) error {
+	ks.mu.RLock()
+	content := encryptedContent{
+		Keys:    make([][]byte, 0, len(ks.keys)),
+		Aliases: make([]Alias, 0, len(ks.keys)),
+	}
+	addresses := make([]string, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		content.Keys = append(content.Keys, keyBytes(key))
+		content.Aliases = append(content.Aliases, Alias{Alias: key.Alias, PublicKeyBase64: suiPublicKeyBase64(key.Signer)})
+		addresses = append(addresses, key.Signer.ToSuiAddress())
+	}
+	ks.mu.RUnlock()
+	defer content.zero()
+
+	plaintext, err := json.Marshal(&content)
+	if err != nil {
+		return err
+	}
+	defer zero(plaintext)
+
+	aead, err := newAEAD(passphrase, kdf)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	file := encryptedFile{
+		Version:   encryptedKeystoreVersion,
+		Addresses: addresses,
+		Kdf:       kdf,
+		Cipher:    CipherParams{Name: CipherAESGCM, Nonce: base64.StdEncoding.EncodeToString(nonce)},
+	}
+	additionalData, err := file.additionalData()
+	if err != nil {
+		return err
+	}
+	file.Ciphertext = base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, plaintext, additionalData))
+
+	if err := os.MkdirAll(filepath.Dir(e.path), 0o700); err != nil {
+		return err
+	}
+	if err := writeJSON(e.path, &file); err != nil {
+		return err
+	}
+	e.file = file
+	return nil
+}
+
+func (e *EncryptedKeystore) open(passphrase []byte) ([]byte, error) {
+	if e.file.Cipher.Name != CipherAESGCM {
+		return nil, ErrUnsupportedCipher
+	}
+	nonce, err := base64.StdEncoding.DecodeString(e.file.Cipher.Nonce)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(e.file.Ciphertext)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	aead, err := newAEAD(passphrase, e.file.Kdf)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrInvalidPassphrase
+	}
+	additionalData, err := e.file.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+	return plaintext, nil
+}
+
+// additionalData authenticates every field of the file but the ciphertext.
+func (f encryptedFile) additionalData() ([]byte, error) {
+	f.Ciphertext = ""
+	return json.Marshal(&f)
+}
+
+func newAEAD(passphrase []byte, kdf KdfParams) (cipher.AEAD, error) {
+	key, err := kdf.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func newKdfParams(options *EncryptOptions) (KdfParams, error) {
+	if options == nil {
+		options = &EncryptOptions{}
+	}
+
+	var kdf KdfParams
+	switch options.Kdf {
+	case "", KdfScrypt:
+		kdf = KdfParams{Name: KdfScrypt, N: options.ScryptN, R: options.ScryptR, P: options.ScryptP}
+		if kdf.N == 0 {
+			kdf.N = DefaultScryptN
+		}
+		if kdf.R == 0 {
+			kdf.R = DefaultScryptR
+		}
+		if kdf.P == 0 {
+			kdf.P = DefaultScryptP
+		}
+	case KdfArgon2id:
+		kdf = KdfParams{Name: KdfArgon2id, Time: options.Argon2Time, Memory: options.Argon2Memory, Threads: options.Argon2Threads}
+		if kdf.Time == 0 {
+			kdf.Time = DefaultArgon2Time
+		}
+		if kdf.Memory == 0 {
+			kdf.Memory = DefaultArgon2Memory
+		}
+		if kdf.Threads == 0 {
+			kdf.Threads = DefaultArgon2Threads
+		}
+	default:
+		return KdfParams{}, ErrUnsupportedKdf
+	}
+
+	return kdf.withNewSalt()
+}
+
+func (p KdfParams) withNewSalt() (KdfParams, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return KdfParams{}, err
+	}
+	p.Salt = base64.StdEncoding.EncodeToString(salt)
+	return p, nil
+}
+
+func (p KdfParams) deriveKey(passphrase []byte) ([]byte, error) {
+	salt, err := base64.StdEncoding.DecodeString(p.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kdf salt: %v", err)
+	}
+
+	switch p.Name {
+	case KdfScrypt:
+		return scrypt.Key(passphrase, salt, p.N, p.R, p.P, derivedKeySize)
+	case KdfArgon2id:
+		if p.Time == 0 || p.Memory == 0 || p.Threads == 0 {
+			return nil, fmt.Errorf("invalid argon2id parameters")
+		}
+		return argon2.IDKey(passphrase, salt, p.Time, p.Memory, p.Threads, derivedKeySize), nil
+	default:
+		return nil, ErrUnsupportedKdf
+	}
+}
@@ -0,0 +1,129 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func TestEncryptedKeystore(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *EncryptOptions
+	}{
+		{name: "scrypt", options: &EncryptOptions{Kdf: KdfScrypt, ScryptN: 1 << 10}},
+		{name: "argon2id", options: &EncryptOptions{Kdf: KdfArgon2id, Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "sui.keystore.enc")
+			passphrase := []byte("correct horse battery staple")
+
+			ks := New("")
+			var addresses []string
+			for _, s := range []scheme.SignatureScheme{scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1} {
+				address, err := ks.Add(s, append(make([]byte, 31), byte(len(addresses)+1)), "")
+				require.NoError(t, err)
+				addresses = append(addresses, address)
+			}
+			require.NoError(t, ks.SetAlias(addresses[0], "main"))
+			_, err := CreateEncryptedKeystore(path, ks, passphrase, tt.options)
+			require.NoError(t, err)
+
+			eks, err := LoadEncryptedKeystore(path)
+			require.NoError(t, err)
+			require.Equal(t, addresses, eks.Addresses())
+
+			_, err = eks.Unlock([]byte("wrong"))
+			require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+			unlocked, err := eks.Unlock(passphrase)
+			require.NoError(t, err)
+			require.Equal(t, addresses, unlocked.Addresses())
+			s, err := unlocked.SignerByAlias("main")
+			require.NoError(t, err)
+			require.Equal(t, addresses[0], s.ToSuiAddress())
+			for _, address := range addresses {
+				s, err := unlocked.Signer(address)
+				require.NoError(t, err)
+				txBytes := []byte("transaction data")
+				signature, err := models.SignWithIntent(context.Background(), s, txBytes, constant.TransactionDataIntentScope)
+				require.NoError(t, err)
+				signer, pass, err := verify.VerifyTransactionSignature(txBytes, signature, nil)
+				require.NoError(t, err)
+				require.True(t, pass)
+				require.Equal(t, address, signer)
+			}
+
+			key, err := unlocked.Key(addresses[1])
+			require.NoError(t, err)
+			secretKey := key.SecretKey
+			unlocked.Zero()
+			require.Equal(t, make([]byte, 32), secretKey)
+			require.Empty(t, unlocked.Addresses())
+
+			newPassphrase := []byte("another passphrase")
+			require.ErrorIs(t, eks.ChangePassphrase([]byte("wrong"), newPassphrase), ErrInvalidPassphrase)
+			require.NoError(t, eks.ChangePassphrase(passphrase, newPassphrase))
+			eks, err = LoadEncryptedKeystore(path)
+			require.NoError(t, err)
+			_, err = eks.Unlock(passphrase)
+			require.ErrorIs(t, err, ErrInvalidPassphrase)
+			exported, err := eks.ExportKey(newPassphrase, addresses[1])
+			require.NoError(t, err)
+			require.Equal(t, encodeTestKey(0x01, append(make([]byte, 31), 2)), exported)
+			exportedBytes, err := eks.ExportKeyBytes(newPassphrase, addresses[1])
+			require.NoError(t, err)
+			require.Equal(t, exported, string(exportedBytes))
+
+			plainPath := filepath.Join(dir, KeystoreFileName)
+			require.NoError(t, eks.Export(newPassphrase, plainPath))
+			plain, err := Load(plainPath)
+			require.NoError(t, err)
+			require.Equal(t, addresses, plain.Addresses())
+			_, err = plain.SignerByAlias("main")
+			require.NoError(t, err)
+
+			require.NoError(t, plain.Remove(addresses[2]))
+			require.NoError(t, eks.Update(plain, newPassphrase))
+			require.Equal(t, addresses[:2], eks.Addresses())
+		})
+	}
+}
+
+func TestEncryptedKeystoreTampered(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sui.keystore.enc")
+	ks := New("")
+	_, err := ks.Add(scheme.ED25519, make([]byte, 32), "")
+	require.NoError(t, err)
+	_, err = CreateEncryptedKeystore(path, ks, []byte("passphrase"), &EncryptOptions{ScryptN: 1 << 10})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var file map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &file))
+	file["addresses"] = []string{"0x1"}
+	data, err = json.Marshal(file)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	eks, err := LoadEncryptedKeystore(path)
+	require.NoError(t, err)
+	_, err = eks.Unlock([]byte("passphrase"))
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	_, err = CreateEncryptedKeystore(path, ks, []byte("passphrase"), &EncryptOptions{Kdf: "pbkdf2"})
+	require.ErrorIs(t, err, ErrUnsupportedKdf)
+	require.ErrorIs(t, New("").Save(), ErrNoPath)
+}
@@ -0,0 +1,443 @@
+// Package keystore reads and writes the keystore, aliases and client configuration files of the
+// Sui CLI, so Go tools can use the same accounts as the `sui` binary.
+package keystore
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/block-vision/sui-go-sdk/common/sui_error"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+const (
+	KeystoreFileName     = "sui.keystore"
+	AliasesFileName      = "sui.aliases"
+	ClientConfigFileName = "client.yaml"
+
+	secretKeySize = 32
+)
+
+var (
+	ErrAliasNotFound = errors.New("alias not in keystore")
+	ErrAliasExists   = errors.New("alias already exists in keystore")
+	ErrInvalidKey    = errors.New("invalid keystore key")
+	ErrNoPath        = errors.New("keystore has no file path")
+)
+
+// DefaultConfigDir returns the directory used by the Sui CLI, `~/.sui/sui_config`.
+func DefaultConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".sui", "sui_config"), nil
+}
+
+// Alias is an entry of the `sui.aliases` file.
+type Alias struct {
+	Alias string `json:"alias"`
+	// PublicKeyBase64 is the base64 public key prefixed with its scheme flag.
+	PublicKeyBase64 string `json:"public_key_base64"`
+}
+
+// Key is a key held by the keystore.
+type Key struct {
+	Alias  string
+	Scheme scheme.SignatureScheme
+	// SecretKey is the 32 bytes secret key, without the scheme flag.
+	SecretKey []byte
+	Signer    models.Signer
+}
+
+// Keystore is the `sui.keystore` file, a JSON array of base64 `flag || secret_key`, together
+// with the `sui.aliases` file next to it.
+type Keystore struct {
+	mu   sync.RWMutex
+	path string
+	keys []*Key
+}
+
+// New returns an empty keystore that will be saved to path. An empty path gives an in-memory keystore.
+func New(path string) *Keystore {
+	return &Keystore{path: path}
+}
+
+// Load loads the keystore at path and the aliases file in the same directory, if any. Keys
+// without an alias get a default one, as the Sui CLI does.
+func Load(path string) (*Keystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var encodedKeys []string
+	if err := json.Unmarshal(data, &encodedKeys); err != nil {
+		return nil, fmt.Errorf("failed to parse keystore %s: %v", path, err)
+	}
+
+	ks := New(path)
+	for _, encodedKey := range encodedKeys {
+		key, err := decodeKey(encodedKey)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys = append(ks.keys, key)
+	}
+
+	aliases, err := loadAliases(ks.aliasesPath())
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range ks.keys {
+		key.Alias = aliases[suiPublicKeyBase64(key.Signer)]
+		if key.Alias == "" {
+			key.Alias = ks.defaultAlias(key.Signer.ToSuiAddress())
+		}
+	}
+
+	return ks, nil
+}
+
+// LoadDefault loads `~/.sui/sui_config/sui.keystore`.
+func LoadDefault() (*Keystore, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return Load(filepath.Join(dir, KeystoreFileName))
+}
+
+// Path returns the path of the keystore file.
+func (ks *Keystore) Path() string {
+	return ks.path
+}
+
+// Save writes the keystore and aliases files, readable by the owner only.
+func (ks *Keystore) Save() error {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if ks.path == "" {
+		return ErrNoPath
+	}
+
+	encodedKeys := make([]string, 0, len(ks.keys))
+	aliases := make([]Alias, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		encodedKeys = append(encodedKeys, encodeKey(key))
+		aliases = append(aliases, Alias{Alias: key.Alias, PublicKeyBase64: suiPublicKeyBase64(key.Signer)})
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ks.path), 0o700); err != nil {
+		return err
+	}
+	if err := writeJSON(ks.path, encodedKeys); err != nil {
+		return err
+	}
+	return writeJSON(ks.aliasesPath(), aliases)
+}
+
+// Addresses lists the addresses of the keystore, in the keystore order.
+func (ks *Keystore) Addresses() []string {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	addresses := make([]string, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		addresses = append(addresses, key.Signer.ToSuiAddress())
+	}
+	return addresses
+}
+
+// Keys returns the keys of the keystore, in the keystore order.
+func (ks *Keystore) Keys() []*Key {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	return append([]*Key(nil), ks.keys...)
+}
+
+// Signer returns the signer of address.
+func (ks *Keystore) Signer(address string) (models.Signer, error) {
+	key, err := ks.Key(address)
+	if err != nil {
+		return nil, err
+	}
+	return key.Signer, nil
+}
+
+// SignerByAlias returns the signer of the key named alias.
+func (ks *Keystore) SignerByAlias(alias string) (models.Signer, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	for _, key := range ks.keys {
+		if key.Alias == alias {
+			return key.Signer, nil
+		}
+	}
+	return nil, ErrAliasNotFound
+}
+
+// SignerByAddressOrAlias accepts either a 0x address or an alias, like the Sui CLI arguments.
+func (ks *Keystore) SignerByAddressOrAlias(addressOrAlias string) (models.Signer, error) {
+	if strings.HasPrefix(addressOrAlias, "0x") {
+		return ks.Signer(addressOrAlias)
+	}
+	return ks.SignerByAlias(addressOrAlias)
+}
+
+// Key returns the key of address.
+func (ks *Keystore) Key(address string) (*Key, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if len(ks.keys) == 0 {
+		return nil, sui_error.ErrNoKeyStoreInfo
+	}
+	if i := ks.indexOf(address); i >= 0 {
+		return ks.keys[i], nil
+	}
+	return nil, sui_error.ErrAddressNotInKeyStore
+}
+
+// Add adds a 32 bytes secret key of the given scheme and returns its address. An empty alias
+// is replaced by a default one. Adding a key already in the keystore only updates its alias.
+func (ks *Keystore) Add(signatureScheme scheme.SignatureScheme, secretKey []byte, alias string) (string, error) {
+	key, err := newKey(signatureScheme, secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	address := key.Signer.ToSuiAddress()
+	if alias == "" {
+		alias = ks.defaultAlias(address)
+	}
+	for _, other := range ks.keys {
+		if other.Alias == alias && other.Signer.ToSuiAddress() != address {
+			return "", ErrAliasExists
+		}
+	}
+	key.Alias = alias
+
+	if i := ks.indexOf(address); i >= 0 {
+		ks.keys[i] = key
+	} else {
+		ks.keys = append(ks.keys, key)
+	}
+	return address, nil
+}
+
+// Remove removes the key of address.
+func (ks *Keystore) Remove(address string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	i := ks.indexOf(address)
+	if i < 0 {
+		return sui_error.ErrAddressNotInKeyStore
+	}
+	ks.keys = append(ks.keys[:i], ks.keys[i+1:]...)
+	return nil
+}
+
+// SetAlias renames the key of address.
+func (ks *Keystore) SetAlias(address string, alias string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	i := ks.indexOf(address)
+	if i < 0 {
+		return sui_error.ErrAddressNotInKeyStore
+	}
+	for j, key := range ks.keys {
+		if key.Alias == alias && j != i {
+			return ErrAliasExists
+		}
+	}
+	ks.keys[i].Alias = alias
+	return nil
+}
+
+// Zero clears the secret keys from memory and empties the keystore. Signers returned before
+// must not be used afterwards.
+func (ks *Keystore) Zero() {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	for _, key := range ks.keys {
+		zero(key.SecretKey)
+		if z, ok := key.Signer.(interface{ Zero() }); ok {
+			z.Zero()
+		}
+	}
+	ks.keys = nil
+}
+
+func (ks *Keystore) indexOf(address string) int {
+	for i, key := range ks.keys {
+		if strings.EqualFold(key.Signer.ToSuiAddress(), address) {
+			return i
+		}
+	}
+	return -1
+}
+
+// defaultAlias names a key after the beginning of its address, the Sui CLI uses random words instead.
+func (ks *Keystore) defaultAlias(address string) string {
+	return "key-" + strings.TrimPrefix(address, "0x")[:8]
+}
+
+func (ks *Keystore) aliasesPath() string {
+	return filepath.Join(filepath.Dir(ks.path), AliasesFileName)
+}
+
+func loadAliases(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases []Alias
+	if err := json.Unmarshal(data, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to parse aliases %s: %v", path, err)
+	}
+	byPublicKey := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		byPublicKey[alias.PublicKeyBase64] = alias.Alias
+	}
+	return byPublicKey, nil
+}
+
+func decodeKey(encodedKey string) (*Key, error) {
+	data, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	defer zero(data)
+	return decodeKeyBytes(data)
+}
+
+// decodeKeyBytes decodes the `flag || secret_key` bytes of a key.
+func decodeKeyBytes(data []byte) (*Key, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidKey
+	}
+	signatureScheme, ok := scheme.SignatureFlagToScheme[data[0]]
+	if !ok {
+		return nil, sui_error.ErrUnknownSignatureScheme
+	}
+	return newKey(signatureScheme, data[1:])
+}
+
+func encodeKey(key *Key) string {
+	data := keyBytes(key)
+	defer zero(data)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// keyBytes returns the `flag || secret_key` bytes of a key, zero them once they are no longer needed.
+func keyBytes(key *Key) []byte {
+	return append([]byte{scheme.SignatureSchemeToFlag[key.Scheme]}, key.SecretKey...)
+}
+
+func newKey(signatureScheme scheme.SignatureScheme, secretKey []byte) (*Key, error) {
+	if len(secretKey) != secretKeySize {
+		return nil, ErrInvalidKey
+	}
+
+	s, err := signer.NewSignerFromSecretKey(signatureScheme, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Scheme:    signatureScheme,
+		SecretKey: append([]byte(nil), secretKey...),
+		Signer:    s,
+	}, nil
+}
+
+// suiPublicKeyBase64 returns the base64 `flag || public_key` used by the aliases file.
+func suiPublicKeyBase64(s models.Signer) string {
+	data := append([]byte{scheme.SignatureSchemeToFlag[s.GetKeyScheme()]}, s.GetPublicKey()...)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, 0o600)
+}
+
+// writeFileAtomic writes data to a temporary file in the directory of path and renames it over
+// path, so a crash leaves either the previous or the new content but never a truncated file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) (err error) {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if err = tmp.Chmod(perm); err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes the directory entry of a rename to disk. Directories cannot be synced on
+// Windows, where the rename is already durable once it returns.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
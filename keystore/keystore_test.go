@@ -0,0 +1,161 @@
+package keystore
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/common/sui_error"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+func encodeTestKey(flag byte, secretKey []byte) string {
+	return base64.StdEncoding.EncodeToString(append([]byte{flag}, secretKey...))
+}
+
+func TestKeystore(t *testing.T) {
+	dir := t.TempDir()
+	edSecret := make([]byte, 32)
+	k1Secret := append(make([]byte, 31), 1)
+	r1Secret := append(make([]byte, 31), 2)
+
+	edSigner := signer.NewSigner(edSecret)
+	k1Signer, err := signer.NewSecp256k1Signer(k1Secret)
+	require.NoError(t, err)
+	r1Signer, err := signer.NewSecp256r1Signer(r1Secret)
+	require.NoError(t, err)
+
+	// the files as written by the sui CLI, the r1 key has no alias
+	keystorePath := filepath.Join(dir, KeystoreFileName)
+	require.NoError(t, os.WriteFile(keystorePath, []byte(`[
+  "`+encodeTestKey(0x00, edSecret)+`",
+  "`+encodeTestKey(0x01, k1Secret)+`",
+  "`+encodeTestKey(0x02, r1Secret)+`"
+]`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AliasesFileName), []byte(`[
+  {
+    "alias": "eloquent-jade",
+    "public_key_base64": "`+base64.StdEncoding.EncodeToString(append([]byte{0x00}, edSigner.PubKey...))+`"
+  },
+  {
+    "alias": "hopeful-opal",
+    "public_key_base64": "`+base64.StdEncoding.EncodeToString(append([]byte{0x01}, k1Signer.PubKey...))+`"
+  }
+]`), 0o600))
+
+	ks, err := Load(keystorePath)
+	require.NoError(t, err)
+	require.Equal(t, []string{edSigner.Address, k1Signer.Address, r1Signer.Address}, ks.Addresses())
+
+	s, err := ks.SignerByAlias("hopeful-opal")
+	require.NoError(t, err)
+	require.Equal(t, k1Signer.Address, s.ToSuiAddress())
+	s, err = ks.SignerByAddressOrAlias(r1Signer.Address)
+	require.NoError(t, err)
+	require.Equal(t, scheme.Secp256r1, s.GetKeyScheme())
+	key, err := ks.Key(r1Signer.Address)
+	require.NoError(t, err)
+	require.Equal(t, "key-"+r1Signer.Address[2:10], key.Alias)
+
+	_, err = ks.Signer("0x1")
+	require.ErrorIs(t, err, sui_error.ErrAddressNotInKeyStore)
+	_, err = ks.SignerByAlias("unknown")
+	require.ErrorIs(t, err, ErrAliasNotFound)
+	_, err = New(keystorePath).Signer(edSigner.Address)
+	require.ErrorIs(t, err, sui_error.ErrNoKeyStoreInfo)
+
+	require.ErrorIs(t, ks.SetAlias(r1Signer.Address, "eloquent-jade"), ErrAliasExists)
+	require.NoError(t, ks.SetAlias(r1Signer.Address, "brave-ruby"))
+	require.NoError(t, ks.Remove(edSigner.Address))
+	newAddress, err := ks.Add(scheme.ED25519, append(make([]byte, 31), 3), "")
+	require.NoError(t, err)
+	_, err = ks.Add(scheme.ED25519, make([]byte, 16), "")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	require.NoError(t, ks.Save())
+
+	reloaded, err := Load(keystorePath)
+	require.NoError(t, err)
+	require.Equal(t, []string{k1Signer.Address, r1Signer.Address, newAddress}, reloaded.Addresses())
+	s, err = reloaded.SignerByAlias("brave-ruby")
+	require.NoError(t, err)
+	require.Equal(t, r1Signer.Address, s.ToSuiAddress())
+	require.Equal(t, encodeTestKey(0x01, k1Secret), encodeKey(reloaded.Keys()[0]))
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, KeystoreFileName)
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o644))
+
+	require.NoError(t, writeFileAtomic(path, []byte("new"), 0o600))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the rename fails on a directory, the temporary file must not be left behind
+	require.NoError(t, os.Mkdir(filepath.Join(dir, AliasesFileName), 0o700))
+	require.Error(t, writeFileAtomic(filepath.Join(dir, AliasesFileName), []byte("new"), 0o600))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestClientConfig(t *testing.T) {
+	dir := t.TempDir()
+	ks := New(filepath.Join(dir, KeystoreFileName))
+	address, err := ks.Add(scheme.ED25519, make([]byte, 32), "main")
+	require.NoError(t, err)
+	require.NoError(t, ks.Save())
+
+	configPath := filepath.Join(dir, ClientConfigFileName)
+	require.NoError(t, os.WriteFile(configPath, []byte(`---
+keystore:
+  File: `+KeystoreFileName+`
+external_keys: ~
+envs:
+  - alias: devnet
+    rpc: "https://fullnode.devnet.sui.io:443"
+    ws: ~
+    basic_auth: ~
+  - alias: testnet
+    rpc: "https://fullnode.testnet.sui.io:443"
+    ws: ~
+    basic_auth: ~
+active_env: testnet
+active_address: "`+address+`"
+`), 0o600))
+
+	config, err := LoadClientConfig(configPath)
+	require.NoError(t, err)
+	rpc, err := config.ActiveRpc()
+	require.NoError(t, err)
+	require.Equal(t, "https://fullnode.testnet.sui.io:443", rpc)
+	s, err := config.ActiveSigner()
+	require.NoError(t, err)
+	require.Equal(t, address, s.ToSuiAddress())
+
+	devnet := "devnet"
+	config.ActiveEnv = &devnet
+	require.NoError(t, config.Save(""))
+	reloaded, err := LoadClientConfig(configPath)
+	require.NoError(t, err)
+	rpc, err = reloaded.ActiveRpc()
+	require.NoError(t, err)
+	require.Equal(t, "https://fullnode.devnet.sui.io:443", rpc)
+	require.Len(t, reloaded.Envs, 2)
+
+	unknown := "mainnet"
+	reloaded.ActiveEnv = &unknown
+	_, err = reloaded.ActiveRpc()
+	require.ErrorIs(t, err, ErrEnvNotFound)
+	reloaded.ActiveAddress = nil
+	_, err = reloaded.ActiveSigner()
+	require.ErrorIs(t, err, ErrNoActiveAddress)
+}
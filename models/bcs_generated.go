@@ -0,0 +1,56 @@
+// Code generated by bcsgen. DO NOT EDIT.
+
+package models
+
+import (
+	"io"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func (v SuiAddressBytes) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *SuiAddressBytes) AppendBCS(b []byte) ([]byte, error) {
+	b = append(b, (*v)[:]...)
+	return b, nil
+}
+
+func (v *SuiAddressBytes) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *SuiAddressBytes) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.ReadFull((*v)[:])
+}
+
+func (v ObjectDigestBytes) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ObjectDigestBytes) AppendBCS(b []byte) ([]byte, error) {
+	b = mystenbcs.AppendULEB128(b, len(*v))
+	b = append(b, *v...)
+	return b, nil
+}
+
+func (v *ObjectDigestBytes) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ObjectDigestBytes) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	if data1 := r.ReadBytes(); data1 != nil {
+		*v = data1
+	}
+}
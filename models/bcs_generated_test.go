@@ -0,0 +1,17 @@
+package models
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcstest"
+)
+
+func TestGeneratedBcs(t *testing.T) {
+	t.Run("SuiAddressBytes", func(t *testing.T) {
+		bcstest.Check[SuiAddressBytes](t, rand.New(rand.NewSource(1)), 100)
+	})
+	t.Run("ObjectDigestBytes", func(t *testing.T) {
+		bcstest.Check[ObjectDigestBytes](t, rand.New(rand.NewSource(1)), 100)
+	})
+}
@@ -1,5 +1,7 @@
 package models
 
+//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+
 import (
 	"reflect"
 
@@ -7,9 +9,14 @@ import (
 )
 
 type SuiAddress string
+
+//bcs:generate
 type SuiAddressBytes [32]byte
+
 type TransactionDigest string
 type ObjectDigest string
+
+//bcs:generate
 type ObjectDigestBytes []byte
 
 func init() {
@@ -1,6 +1,13 @@
 package models
 
-import "github.com/block-vision/sui-go-sdk/constant"
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+)
 
 type AppId int
 
@@ -14,14 +21,95 @@ const (
 	V0 IntentVersion = 0
 )
 
+// IntentSize is the size of a serialized Intent.
+const IntentSize = 3
+
+// Intent https://github.com/MystenLabs/sui/blob/main/crates/shared-crypto/src/intent.rs
+// is the domain separator prepended to every message signed by a Sui key, serialized as
+// `scope || version || app_id`.
+type Intent struct {
+	Scope   constant.IntentScope
+	Version IntentVersion
+	AppId   AppId
+}
+
+// NewIntent returns the V0 Sui intent for the scope.
+func NewIntent(scope constant.IntentScope) Intent {
+	return Intent{Scope: scope, Version: V0, AppId: Sui}
+}
+
+// TransactionDataIntent is `Intent::sui_transaction()`, serialized as 0x000000.
+func TransactionDataIntent() Intent {
+	return NewIntent(constant.TransactionDataIntentScope)
+}
+
+// PersonalMessageIntent is `Intent::personal_message()`, serialized as 0x030000.
+func PersonalMessageIntent() Intent {
+	return NewIntent(constant.PersonalMessageIntentScope)
+}
+
+// ParseIntent parses a serialized intent, only the V0 Sui intents are supported.
+func ParseIntent(data []byte) (Intent, error) {
+	if len(data) < IntentSize {
+		return Intent{}, fmt.Errorf("invalid intent length: %d", len(data))
+	}
+	intent := Intent{Scope: data[0], Version: IntentVersion(data[1]), AppId: AppId(data[2])}
+	if intent.Version != V0 || intent.AppId != Sui {
+		return Intent{}, fmt.Errorf("unsupported intent version %d or app id %d", intent.Version, intent.AppId)
+	}
+	return intent, nil
+}
+
+// Bytes returns the serialized intent.
+func (i Intent) Bytes() []byte {
+	return []byte{i.Scope, byte(i.Version), byte(i.AppId)}
+}
+
+// IntentMessage is a BCS serialized value together with its intent.
+type IntentMessage struct {
+	Intent Intent
+	// Value is the BCS serialized value, e.g. the TransactionData bytes.
+	Value []byte
+}
+
+// NewTransactionDataIntentMessage wraps BCS serialized TransactionData bytes.
+func NewTransactionDataIntentMessage(txBytes []byte) IntentMessage {
+	return IntentMessage{Intent: TransactionDataIntent(), Value: txBytes}
+}
+
+// NewPersonalMessageIntentMessage wraps a personal message. As in `PersonalMessage { message: Vec<u8> }`
+// the message is BCS serialized, i.e. prefixed with its ULEB128 length.
+func NewPersonalMessageIntentMessage(message []byte) IntentMessage {
+	value := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(message)), uint64(len(message)))
+	return IntentMessage{Intent: PersonalMessageIntent(), Value: append(value, message...)}
+}
+
+// ParseIntentMessage splits a serialized intent message into its intent and value.
+func ParseIntentMessage(data []byte) (IntentMessage, error) {
+	intent, err := ParseIntent(data)
+	if err != nil {
+		return IntentMessage{}, err
+	}
+	return IntentMessage{Intent: intent, Value: data[IntentSize:]}, nil
+}
+
+// Bytes returns the serialized intent message `intent || value`.
+func (m IntentMessage) Bytes() []byte {
+	intentMessage := make([]byte, 0, IntentSize+len(m.Value))
+	intentMessage = append(intentMessage, m.Intent.Bytes()...)
+	return append(intentMessage, m.Value...)
+}
+
+// Digest returns the blake2b digest of the intent message, which is what Sui keys sign.
+func (m IntentMessage) Digest() [32]byte {
+	return blake2b.Sum256(m.Bytes())
+}
+
 func IntentWithScope(intentScope constant.IntentScope) []int {
 	return []int{int(intentScope), int(V0), int(Sui)}
 }
 
+// NewMessageWithIntent returns the intent message of the BCS serialized message for the scope.
 func NewMessageWithIntent(message []byte, scope constant.IntentScope) []byte {
-	intent := []byte{scope, 0, 0}
-	intentMessage := make([]byte, len(intent)+len(message))
-	copy(intentMessage, intent)
-	copy(intentMessage[len(intent):], message)
-	return intentMessage
+	return IntentMessage{Intent: NewIntent(scope), Value: message}.Bytes()
 }
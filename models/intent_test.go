@@ -0,0 +1,93 @@
+package models
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+)
+
+// The expected bytes are those of the shared-crypto and sui-types Rust crates.
+func TestIntentMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message IntentMessage
+		want    string
+	}{
+		{
+			name:    "Intent::sui_transaction",
+			message: IntentMessage{Intent: TransactionDataIntent()},
+			want:    "000000",
+		},
+		{
+			name:    "Intent::personal_message",
+			message: IntentMessage{Intent: PersonalMessageIntent()},
+			want:    "030000",
+		},
+		{
+			name:    "transaction data is not prefixed",
+			message: NewTransactionDataIntentMessage([]byte{0x00, 0x00, 0x01, 0x02}),
+			want:    "00000000000102",
+		},
+		{
+			name:    "PersonalMessage is BCS serialized",
+			message: NewPersonalMessageIntentMessage([]byte("Hello")),
+			want:    "0300000548656c6c6f",
+		},
+		{
+			name:    "empty PersonalMessage",
+			message: NewPersonalMessageIntentMessage(nil),
+			want:    "03000000",
+		},
+		{
+			name:    "PersonalMessage of 200 bytes has a two bytes ULEB128 length",
+			message: NewPersonalMessageIntentMessage(bytes.Repeat([]byte{0xaa}, 200)),
+			want:    "030000c801" + hex.EncodeToString(bytes.Repeat([]byte{0xaa}, 200)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serialized := tt.message.Bytes()
+			require.Equal(t, tt.want, hex.EncodeToString(serialized))
+
+			parsed, err := ParseIntentMessage(serialized)
+			require.NoError(t, err)
+			require.Equal(t, tt.message.Intent, parsed.Intent)
+			require.Equal(t, tt.message.Digest(), parsed.Digest())
+		})
+	}
+
+	require.Equal(t, NewMessageWithIntent([]byte{0x01}, constant.TransactionEffectsIntentScope), []byte{0x01, 0x00, 0x00, 0x01})
+}
+
+func TestParseIntent(t *testing.T) {
+	intent, err := ParseIntent([]byte{0x02, 0x00, 0x00})
+	require.NoError(t, err)
+	require.Equal(t, NewIntent(constant.CheckpointSummaryIntentScope), intent)
+
+	for _, data := range [][]byte{nil, {0x00, 0x00}, {0x00, 0x01, 0x00}, {0x03, 0x00, 0x01}} {
+		_, err := ParseIntent(data)
+		require.Error(t, err, hex.EncodeToString(data))
+	}
+}
+
+func TestVerifyIntentMessage(t *testing.T) {
+	signature := "AIjj13rXd9GFZRNPd4XNUvthHMHg5bovf8/mW4a7EYAWC6mQtAAaa0tSPhk6YpNED34/qeaCYwnN1QAsKm253gfQ6i6fULpM+uscFuJIXoTT/JQvMo3CUlLODcGxPkUbHg=="
+	message := []byte("123456 is the thing that you need to sign")
+
+	signer, pass, err := VerifyIntentMessage(NewPersonalMessageIntentMessage(message), signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, "0x00dccd645260cfe9145bdabb7b45b42e188af8661086aa7bb2e7f3adc1cd2785", signer)
+
+	// the same bytes under another intent or without the BCS length must not verify
+	_, pass, err = VerifyIntentMessage(IntentMessage{Intent: PersonalMessageIntent(), Value: message}, signature)
+	require.NoError(t, err)
+	require.False(t, pass)
+	_, pass, err = VerifyIntentMessage(NewTransactionDataIntentMessage(NewPersonalMessageIntentMessage(message).Value), signature)
+	require.NoError(t, err)
+	require.False(t, pass)
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ExtraFields are the JSON fields of a response that its model doesn't declare. They are kept so
+// that fields added by newer nodes aren't lost, and are encoded back with the model.
+type ExtraFields map[string]json.RawMessage
+
+// unmarshalWithExtra decodes data into v, a pointer to a struct, and returns the fields of data
+// that v doesn't declare.
+func unmarshalWithExtra(data []byte, v any) (ExtraFields, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || len(fields) == 0 {
+		// null
+		return nil, nil
+	}
+
+	declared := jsonFieldNames(reflect.TypeOf(v).Elem())
+	extra := ExtraFields{}
+	for name, value := range fields {
+		if !declared.has(name) {
+			extra[name] = value
+		}
+	}
+	if len(extra) == 0 {
+		return nil, nil
+	}
+	return extra, nil
+}
+
+// marshalWithExtra encodes v, a struct, followed by the extra fields it doesn't declare.
+func marshalWithExtra(v any, extra ExtraFields) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	declared := jsonFieldNames(reflect.TypeOf(v))
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if !declared.has(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.Write(data[:len(data)-1])
+	for _, name := range names {
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(extra[name])
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// marshalJSONWithExtra implements the MarshalJSON of a model T keeping its extra fields: v is
+// encoded without its JSON methods, followed by the extra fields.
+func marshalJSONWithExtra[T any](v T, extra ExtraFields) ([]byte, error) {
+	plain := reflect.ValueOf(v).Convert(plainType(reflect.TypeFor[T]()))
+	return marshalWithExtra(plain.Interface(), extra)
+}
+
+// unmarshalJSONWithExtra implements the UnmarshalJSON of a model T keeping its extra fields: v is
+// reset and decoded without its JSON methods, the fields it doesn't declare are stored in extra.
+func unmarshalJSONWithExtra[T any](data []byte, v *T, extra *ExtraFields) error {
+	var zero T
+	*v = zero
+	plain := reflect.ValueOf(v).Convert(reflect.PointerTo(plainType(reflect.TypeFor[T]())))
+	fields, err := unmarshalWithExtra(data, plain.Interface())
+	*extra = fields
+	return err
+}
+
+var plainTypeCache sync.Map
+
+// plainType returns a struct type with the fields of the struct type t but none of its methods,
+// so that encoding/json doesn't call the JSON methods of t again.
+func plainType(t reflect.Type) reflect.Type {
+	if plain, ok := plainTypeCache.Load(t); ok {
+		return plain.(reflect.Type)
+	}
+	fields := make([]reflect.StructField, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i)
+	}
+	plain := reflect.StructOf(fields)
+	plainTypeCache.Store(t, plain)
+	return plain
+}
+
+type fieldNames map[string]struct{}
+
+// has matches a JSON field name like encoding/json, which ignores the case.
+func (f fieldNames) has(name string) bool {
+	_, ok := f[strings.ToLower(name)]
+	return ok
+}
+
+var jsonFieldNamesCache sync.Map
+
+// jsonFieldNames returns the lower case JSON names of the fields of a struct type, with the fields
+// of its embedded structs.
+func jsonFieldNames(t reflect.Type) fieldNames {
+	if names, ok := jsonFieldNamesCache.Load(t); ok {
+		return names.(fieldNames)
+	}
+	names := fieldNames{}
+	addJSONFieldNames(t, names)
+	jsonFieldNamesCache.Store(t, names)
+	return names
+}
+
+func addJSONFieldNames(t reflect.Type, names fieldNames) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addJSONFieldNames(embedded, names)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = struct{}{}
+	}
+}
@@ -0,0 +1,245 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+// OwnerKind is the kind of an [Owner].
+type OwnerKind int
+
+const (
+	// OwnerKindUnknown is the kind of an empty owner, or of a kind this version doesn't know.
+	OwnerKindUnknown OwnerKind = iota
+	OwnerKindAddress
+	OwnerKindObject
+	OwnerKindShared
+	OwnerKindImmutable
+	OwnerKindConsensusAddress
+)
+
+func (k OwnerKind) String() string {
+	switch k {
+	case OwnerKindAddress:
+		return "AddressOwner"
+	case OwnerKindObject:
+		return "ObjectOwner"
+	case OwnerKindShared:
+		return "Shared"
+	case OwnerKindImmutable:
+		return "Immutable"
+	case OwnerKindConsensusAddress:
+		return "ConsensusAddressOwner"
+	default:
+		return "Unknown"
+	}
+}
+
+// Owner is the owner of an object: an address, another object, shared, immutable, or an address
+// through consensus. Its [Owner.Kind] tells which accessor returns it.
+//
+// It is encoded in JSON as the `owner` of the JSON-RPC responses, e.g. `"Immutable"` or
+// `{"AddressOwner": "0x..."}`. A kind this version doesn't know is kept and encoded back as is.
+type Owner struct {
+	kind OwnerKind
+	// address is the address or the object id of the owner
+	address string
+	// version is the initial shared version of a shared object, or the start version of a
+	// consensus address owner
+	version uint64
+	// raw is the JSON of an unknown kind
+	raw json.RawMessage
+}
+
+func NewAddressOwner(address string) Owner {
+	return Owner{kind: OwnerKindAddress, address: address}
+}
+
+func NewObjectOwner(objectId string) Owner {
+	return Owner{kind: OwnerKindObject, address: objectId}
+}
+
+func NewSharedOwner(initialSharedVersion uint64) Owner {
+	return Owner{kind: OwnerKindShared, version: initialSharedVersion}
+}
+
+func NewImmutableOwner() Owner {
+	return Owner{kind: OwnerKindImmutable}
+}
+
+func NewConsensusAddressOwner(address string, startVersion uint64) Owner {
+	return Owner{kind: OwnerKindConsensusAddress, address: address, version: startVersion}
+}
+
+// OwnerFromProto converts the owner of the gRPC API.
+func OwnerFromProto(owner *v2.Owner) (Owner, error) {
+	if owner == nil {
+		return Owner{}, errors.New("empty owner")
+	}
+	switch owner.GetKind() {
+	case v2.Owner_ADDRESS:
+		if owner.Address == nil {
+			return Owner{}, errors.New("address owner without address")
+		}
+		return NewAddressOwner(owner.GetAddress()), nil
+	case v2.Owner_OBJECT:
+		if owner.Address == nil {
+			return Owner{}, errors.New("object owner without object id")
+		}
+		return NewObjectOwner(owner.GetAddress()), nil
+	case v2.Owner_SHARED:
+		if owner.Version == nil {
+			return Owner{}, errors.New("shared owner without initial shared version")
+		}
+		return NewSharedOwner(owner.GetVersion()), nil
+	case v2.Owner_IMMUTABLE:
+		return NewImmutableOwner(), nil
+	case v2.Owner_CONSENSUS_ADDRESS:
+		if owner.Address == nil || owner.Version == nil {
+			return Owner{}, errors.New("consensus address owner without address or start version")
+		}
+		return NewConsensusAddressOwner(owner.GetAddress(), owner.GetVersion()), nil
+	default:
+		return Owner{}, fmt.Errorf("unknown owner kind %s", owner.GetKind())
+	}
+}
+
+func (o Owner) Kind() OwnerKind {
+	return o.kind
+}
+
+// AddressOwner returns the address owning the object.
+func (o Owner) AddressOwner() (string, bool) {
+	if o.kind != OwnerKindAddress {
+		return "", false
+	}
+	return o.address, true
+}
+
+// ObjectOwner returns the id of the object owning the object.
+func (o Owner) ObjectOwner() (string, bool) {
+	if o.kind != OwnerKindObject {
+		return "", false
+	}
+	return o.address, true
+}
+
+// Shared returns the initial shared version of a shared object.
+func (o Owner) Shared() (initialSharedVersion uint64, ok bool) {
+	if o.kind != OwnerKindShared {
+		return 0, false
+	}
+	return o.version, true
+}
+
+func (o Owner) IsImmutable() bool {
+	return o.kind == OwnerKindImmutable
+}
+
+// ConsensusAddressOwner returns the address owning the object through consensus, and the version
+// since it does.
+func (o Owner) ConsensusAddressOwner() (address string, startVersion uint64, ok bool) {
+	if o.kind != OwnerKindConsensusAddress {
+		return "", 0, false
+	}
+	return o.address, o.version, true
+}
+
+type ownerJSON struct {
+	AddressOwner          *string                    `json:"AddressOwner,omitempty"`
+	ObjectOwner           *string                    `json:"ObjectOwner,omitempty"`
+	Shared                *sharedOwnerJSON           `json:"Shared,omitempty"`
+	ConsensusAddressOwner *consensusAddressOwnerJSON `json:"ConsensusAddressOwner,omitempty"`
+}
+
+type sharedOwnerJSON struct {
+	InitialSharedVersion json.Number `json:"initial_shared_version"`
+}
+
+type consensusAddressOwnerJSON struct {
+	StartVersion json.Number `json:"start_version"`
+	Owner        string      `json:"owner"`
+}
+
+func (o Owner) MarshalJSON() ([]byte, error) {
+	var owner ownerJSON
+	switch o.kind {
+	case OwnerKindAddress:
+		owner.AddressOwner = &o.address
+	case OwnerKindObject:
+		owner.ObjectOwner = &o.address
+	case OwnerKindShared:
+		owner.Shared = &sharedOwnerJSON{InitialSharedVersion: json.Number(strconv.FormatUint(o.version, 10))}
+	case OwnerKindImmutable:
+		return json.Marshal(OwnerKindImmutable.String())
+	case OwnerKindConsensusAddress:
+		owner.ConsensusAddressOwner = &consensusAddressOwnerJSON{StartVersion: json.Number(strconv.FormatUint(o.version, 10)), Owner: o.address}
+	default:
+		if o.raw != nil {
+			return o.raw, nil
+		}
+		return []byte("null"), nil
+	}
+	return json.Marshal(owner)
+}
+
+// UnmarshalJSON decodes the owner, the versions are numbers or strings.
+func (o *Owner) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	*o = Owner{}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var kind string
+		if err := json.Unmarshal(data, &kind); err != nil {
+			return err
+		}
+		if kind == OwnerKindImmutable.String() {
+			*o = NewImmutableOwner()
+		} else {
+			o.raw = append(json.RawMessage(nil), data...)
+		}
+		return nil
+	}
+
+	var owner ownerJSON
+	if err := json.Unmarshal(data, &owner); err != nil {
+		return fmt.Errorf("invalid owner: %v", err)
+	}
+	switch {
+	case owner.AddressOwner != nil:
+		*o = NewAddressOwner(*owner.AddressOwner)
+	case owner.ObjectOwner != nil:
+		*o = NewObjectOwner(*owner.ObjectOwner)
+	case owner.Shared != nil:
+		version, err := parseOwnerVersion(owner.Shared.InitialSharedVersion)
+		if err != nil {
+			return err
+		}
+		*o = NewSharedOwner(version)
+	case owner.ConsensusAddressOwner != nil:
+		version, err := parseOwnerVersion(owner.ConsensusAddressOwner.StartVersion)
+		if err != nil {
+			return err
+		}
+		*o = NewConsensusAddressOwner(owner.ConsensusAddressOwner.Owner, version)
+	default:
+		o.raw = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func parseOwnerVersion(version json.Number) (uint64, error) {
+	v, err := strconv.ParseUint(version.String(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid owner version %q: %v", version, err)
+	}
+	return v, nil
+}
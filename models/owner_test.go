@@ -0,0 +1,132 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+func TestOwnerJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		json  string
+		owner Owner
+		// encoded is the JSON of the owner when it isn't the input
+		encoded string
+	}{
+		{name: "address", json: `{"AddressOwner":"0x1"}`, owner: NewAddressOwner("0x1")},
+		{name: "object", json: `{"ObjectOwner":"0x2"}`, owner: NewObjectOwner("0x2")},
+		{name: "shared", json: `{"Shared":{"initial_shared_version":18446744073709551615}}`, owner: NewSharedOwner(18446744073709551615)},
+		{name: "shared version string", json: `{"Shared":{"initial_shared_version":"7"}}`, owner: NewSharedOwner(7), encoded: `{"Shared":{"initial_shared_version":7}}`},
+		{name: "immutable", json: `"Immutable"`, owner: NewImmutableOwner()},
+		{name: "consensus address", json: `{"ConsensusAddressOwner":{"start_version":3,"owner":"0x3"}}`, owner: NewConsensusAddressOwner("0x3", 3)},
+		{name: "null", json: `null`, owner: Owner{}},
+		{name: "unknown", json: `{"Party":{"x":1}}`, owner: Owner{raw: json.RawMessage(`{"Party":{"x":1}}`)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var owner Owner
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &owner))
+			require.Equal(t, tt.owner, owner)
+
+			encoded, err := json.Marshal(owner)
+			require.NoError(t, err)
+			if tt.encoded == "" {
+				tt.encoded = tt.json
+			}
+			require.JSONEq(t, tt.encoded, string(encoded))
+		})
+	}
+
+	var owner Owner
+	require.Error(t, json.Unmarshal([]byte(`{"Shared":{"initial_shared_version":-1}}`), &owner))
+	require.Error(t, json.Unmarshal([]byte(`[]`), &owner))
+}
+
+func TestOwnerAccessors(t *testing.T) {
+	owners := []Owner{
+		NewAddressOwner("0x1"),
+		NewObjectOwner("0x2"),
+		NewSharedOwner(5),
+		NewImmutableOwner(),
+		NewConsensusAddressOwner("0x3", 6),
+		{},
+	}
+	for _, owner := range owners {
+		address, isAddress := owner.AddressOwner()
+		objectId, isObject := owner.ObjectOwner()
+		initialSharedVersion, isShared := owner.Shared()
+		consensusAddress, startVersion, isConsensus := owner.ConsensusAddressOwner()
+
+		require.Equal(t, owner.Kind() == OwnerKindAddress, isAddress)
+		require.Equal(t, owner.Kind() == OwnerKindObject, isObject)
+		require.Equal(t, owner.Kind() == OwnerKindShared, isShared)
+		require.Equal(t, owner.Kind() == OwnerKindImmutable, owner.IsImmutable())
+		require.Equal(t, owner.Kind() == OwnerKindConsensusAddress, isConsensus)
+
+		switch owner.Kind() {
+		case OwnerKindAddress:
+			require.Equal(t, "0x1", address)
+		case OwnerKindObject:
+			require.Equal(t, "0x2", objectId)
+		case OwnerKindShared:
+			require.Equal(t, uint64(5), initialSharedVersion)
+		case OwnerKindConsensusAddress:
+			require.Equal(t, "0x3", consensusAddress)
+			require.Equal(t, uint64(6), startVersion)
+		}
+	}
+}
+
+func TestOwnerFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		owner   *v2.Owner
+		want    Owner
+		wantErr bool
+	}{
+		{name: "address", owner: &v2.Owner{Kind: v2.Owner_ADDRESS.Enum(), Address: proto.String("0x1")}, want: NewAddressOwner("0x1")},
+		{name: "object", owner: &v2.Owner{Kind: v2.Owner_OBJECT.Enum(), Address: proto.String("0x2")}, want: NewObjectOwner("0x2")},
+		{name: "shared", owner: &v2.Owner{Kind: v2.Owner_SHARED.Enum(), Version: proto.Uint64(4)}, want: NewSharedOwner(4)},
+		{name: "immutable", owner: &v2.Owner{Kind: v2.Owner_IMMUTABLE.Enum()}, want: NewImmutableOwner()},
+		{name: "consensus address", owner: &v2.Owner{Kind: v2.Owner_CONSENSUS_ADDRESS.Enum(), Address: proto.String("0x3"), Version: proto.Uint64(5)}, want: NewConsensusAddressOwner("0x3", 5)},
+		{name: "shared without version", owner: &v2.Owner{Kind: v2.Owner_SHARED.Enum()}, wantErr: true},
+		{name: "unknown kind", owner: &v2.Owner{}, wantErr: true},
+		{name: "nil", owner: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := OwnerFromProto(tt.owner)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, owner)
+		})
+	}
+}
+
+func TestObjectChangeOwner(t *testing.T) {
+	var changes []ObjectChange
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"type":"created","owner":"Immutable","objectId":"0x1"},
+		{"type":"mutated","owner":{"Shared":{"initial_shared_version":9}},"objectId":"0x2"},
+		{"type":"mutated","owner":{"AddressOwner":"0x3"},"objectId":"0x4"}
+	]`), &changes))
+
+	require.True(t, changes[0].Owner.IsImmutable())
+	require.Equal(t, ObjectShare{InitialSharedVersion: 9}, changes[1].GetObjectOwnerShare())
+	require.Equal(t, "0x3", changes[2].GetObjectChangeAddressOwner())
+	require.Empty(t, changes[2].GetObjectChangeObjectOwner())
+
+	var balance BalanceChanges
+	require.NoError(t, json.Unmarshal([]byte(`{"owner":"Immutable","coinType":"0x2::sui::SUI","amount":"1"}`), &balance))
+	require.Equal(t, "Immutable", balance.GetBalanceChangeOwner())
+}
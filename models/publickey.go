@@ -0,0 +1,22 @@
+package models
+
+import (
+	"encoding/hex"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// ToSuiBytes returns the Sui representation `flag || pubkey` of a public key.
+func ToSuiBytes(flag byte, pubKey []byte) []byte {
+	suiBytes := make([]byte, 1+len(pubKey))
+	suiBytes[0] = flag
+	copy(suiBytes[1:], pubKey)
+	return suiBytes
+}
+
+// PublicKeyToSuiAddress returns the address of a public key, blake2b(flag || pubkey). It is the
+// derivation of every scheme but multisig, whose public key is hashed member by member.
+func PublicKeyToSuiAddress(flag byte, pubKey []byte) string {
+	addrBytes := blake2b.Sum256(ToSuiBytes(flag, pubKey))
+	return "0x" + hex.EncodeToString(addrBytes[:])
+}
@@ -17,7 +17,17 @@ type SuiEventResponse struct {
 	Type              string                 `json:"type"`
 	ParsedJson        map[string]interface{} `json:"parsedJson"`
 	Bcs               string                 `json:"bcs"`
+	BcsEncoding       string                 `json:"bcsEncoding,omitempty"` // "base64", or the legacy "base58" when empty
 	TimestampMs       string                 `json:"timestampMs"`
+	Extra             ExtraFields            `json:"-"`
+}
+
+func (e SuiEventResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(e, e.Extra)
+}
+
+func (e *SuiEventResponse) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, e, &e.Extra)
 }
 
 type GetEventsResponse []*SuiEventResponse
@@ -48,54 +58,72 @@ type SuiEventFilter map[string]interface{}
 
 // the event query by `Package`: Move package ID
 // JSON-RPC Parameter Example: {"Package":"<PACKAGE-ID>"}
+//
+// Deprecated: use filter.Event.Package of the filter package.
 type EventFilterByPackage struct {
 	Package string `json:"Package"`
 }
 
 // the event query by `MoveModule`: Move module where the event was emitted
 // JSON-RPC Parameter Example: {"MoveModule": {"package": "<PACKAGE-ID>", "module": "nft"}}
+//
+// Deprecated: use filter.Event.MoveModule of the filter package.
 type EventFilterByMoveModule struct {
 	MoveModule MoveModule `json:"MoveModule"`
 }
 
 // the event query by `MoveEventType`: Move event type defined in the move code
 // JSON-RPC Parameter Example:{"MoveEventType":"<PACKAGE-ID>::nft::MintNFTEvent"}
+//
+// Deprecated: use filter.Event.MoveEventType of the filter package.
 type EventFilterByMoveEventType struct {
 	MoveEventType string `json:"MoveEventType"`
 }
 
 // the event query by `MoveEventModule`: Move event module defined in the move code
 // JSON-RPC Parameter Example: {"MoveEventModule": {"package": "<PACKAGE-ID>", "module": "nft", "event": "MintNFTEvent"}}
+//
+// Deprecated: use filter.Event.MoveEventModule of the filter package.
 type EventFilterByMoveEventModule struct {
 	MoveEventModule MoveEventModule `json:"MoveEventModule"`
 }
 
 // the event query by `MoveEventField`: Filter using the data fields in the move event object
 // JSON-RPC Parameter Example: {"MoveEventField":{ "path":"/name", "value":"NFT"}}
+//
+// Deprecated: use filter.Event.MoveEventField of the filter package.
 type EventFilterByMoveEventField struct {
 	MoveEventField MoveEventField `json:"MoveEventField"`
 }
 
 // the event query by `Transaction`: Filter Transaction hash
 // JSON-RPC Parameter Example: {"Transaction":"ENmjG42TE4GyqYb1fGNwJe7oxBbbXWCdNfRiQhCNLBJQ"}
+//
+// Deprecated: use filter.Event.Transaction of the filter package.
 type EventFilterByTransaction struct {
 	Transaction string `json:"Transaction"`
 }
 
 // the event query by `TimeRange`: Time range in millisecond
 // JSON-RPC Parameter Example: {"TimeRange": {"start_time": "1685959791871", "end_time": "1685959791871"}}
+//
+// Deprecated: use filter.Event.TimeRange of the filter package.
 type EventFilterByTimeRange struct {
 	TimeRange TimeRange `json:"TimeRange"`
 }
 
 // the event query by `Sender`: Filter Sender address
 // JSON-RPC Parameter Example: {"Sender":"0x008e9c621f4fdb210b873aab59a1e5bf32ddb1d33ee85eb069b348c234465106"}
+//
+// Deprecated: use filter.Event.Sender of the filter package.
 type EventFilterBySuiAddress struct {
 	Sender string `json:"Sender"`
 }
 
 // the event query by `SenderAddress`: Address that started the transaction
 // JSON-RPC Parameter Example: {"SenderAddress": "0x008e9c621f4fdb210b873aab59a1e5bf32ddb1d33ee85eb069b348c234465106"}
+//
+// Deprecated: use filter.Event.Sender of the filter package.
 type EventFilterBySenderAddress struct {
 	SenderAddress string `json:"SenderAddress"`
 }
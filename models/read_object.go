@@ -18,30 +18,37 @@ type SuiObjectDataOptions struct {
 
 type SuiObjectDataFilter map[string]interface{}
 
+// Deprecated: use filter.Object.Package of the filter package.
 type ObjectFilterByPackage struct {
 	Package string `json:"Package"`
 }
 
+// Deprecated: use filter.Object.StructType of the filter package.
 type ObjectFilterByStructType struct {
 	StructType string `json:"StructType"`
 }
 
+// Deprecated: use filter.Object.AddressOwner of the filter package.
 type ObjectFilterByAddressOwner struct {
 	AddressOwner string `json:"AddressOwner"`
 }
 
+// Deprecated: use filter.Object.ObjectOwner of the filter package.
 type ObjectFilterByObjectOwner struct {
 	ObjectOwner string `json:"ObjectOwner"`
 }
 
+// Deprecated: use filter.Object.ObjectId of the filter package.
 type ObjectFilterByObjectId struct {
 	ObjectId string `json:"ObjectId"`
 }
 
+// Deprecated: use filter.Object.ObjectIds of the filter package.
 type ObjectFilterByObjectIds struct {
 	ObjectIds []string `json:"ObjectIds"`
 }
 
+// Deprecated: use filter.Object.Version of the filter package.
 type ObjectFilterByVersion struct {
 	Version string `json:"Version"`
 }
@@ -90,6 +97,9 @@ type SuiObjectResponseError struct {
 	Digest   string `json:"digest"`
 }
 
+// ObjectOwner is the JSON object of an owner.
+//
+// Deprecated: use [Owner], which also decodes the immutable and the consensus address owners.
 type ObjectOwner struct {
 	// the owner's Sui address
 	AddressOwner string      `json:"AddressOwner"`
@@ -170,7 +180,7 @@ type SuiObjectData struct {
 	Version             string                `json:"version"`
 	Digest              string                `json:"digest"`
 	Type                string                `json:"type"`
-	Owner               interface{}           `json:"owner"`
+	Owner               Owner                 `json:"owner"`
 	PreviousTransaction string                `json:"previousTransaction,omitempty"`
 	StorageRebate       string                `json:"storageRebate"`
 	Display             DisplayFieldsResponse `json:"display"`
@@ -24,16 +24,32 @@ type SuiGetTransactionBlockRequest struct {
 	Options SuiTransactionBlockOptions `json:"options"`
 }
 
-type SuiArgument map[string]interface{}
-type SuiCallArg map[string]interface{}
-
+// SuiTransactionBlockKind is the kind of a transaction. The inputs and the commands are those of a
+// programmable transaction, the fields of the other kinds are kept in Extra.
 type SuiTransactionBlockKind struct {
-	Kind         string       `json:"kind"`
-	Inputs       []SuiCallArg `json:"inputs"`
-	Transactions []any        `json:"transactions"`
+	Kind         string               `json:"kind"`
+	Inputs       []SuiCallArg         `json:"inputs,omitempty"`
+	Transactions []SuiTransactionEnum `json:"transactions,omitempty"`
+	Extra        ExtraFields          `json:"-"`
+}
+
+func (k SuiTransactionBlockKind) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(k, k.Extra)
+}
+
+func (k *SuiTransactionBlockKind) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, k, &k.Extra)
 }
 
+// MoveCall returns the Move call of a command, or nil.
 func MoveCall(data any) *MoveCallSuiTransaction {
+	switch command := data.(type) {
+	case SuiTransactionEnum:
+		return command.MoveCall
+	case *SuiTransactionEnum:
+		return command.MoveCall
+	}
+
 	bs, _ := json.Marshal(data)
 	res := gjson.GetBytes(bs, "MoveCall").Raw
 
@@ -45,27 +61,9 @@ func MoveCall(data any) *MoveCallSuiTransaction {
 	return nil
 }
 
-type SuiTransactionEnum struct {
-	MakeMoveVec     []interface{}           `json:"MakeMoveVec,omitempty"`
-	MergeCoins      []interface{}           `json:"MergeCoins,omitempty"`
-	SplitCoins      []interface{}           `json:"SplitCoins,omitempty"`
-	TransferObjects []interface{}           `json:"TransferObjects,omitempty"`
-	Publish         []interface{}           `json:"Publish,omitempty"`
-	Upgrade         []interface{}           `json:"Upgrade,omitempty"`
-	MoveCall        *MoveCallSuiTransaction `json:"MoveCall,omitempty"`
-}
-
 type ProgrammableTransaction struct {
-	Transactions []any        `json:"transactions"`
-	Inputs       []SuiCallArg `json:"inputs"`
-}
-
-type MoveCallSuiTransaction struct {
-	Package       string        `json:"package"`
-	Module        string        `json:"module"`
-	Function      string        `json:"function"`
-	TypeArguments []string      `json:"type_arguments"`
-	Arguments     []interface{} `json:"arguments"`
+	Transactions []SuiTransactionEnum `json:"transactions"`
+	Inputs       []SuiCallArg         `json:"inputs"`
 }
 
 type SuiTransactionBlockData struct {
@@ -73,6 +71,15 @@ type SuiTransactionBlockData struct {
 	Transaction    SuiTransactionBlockKind `json:"transaction"`
 	Sender         string                  `json:"sender"`
 	GasData        SuiGasData              `json:"gasData"`
+	Extra          ExtraFields             `json:"-"`
+}
+
+func (d SuiTransactionBlockData) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(d, d.Extra)
+}
+
+func (d *SuiTransactionBlockData) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, d, &d.Extra)
 }
 
 type SuiTransactionBlock struct {
@@ -94,33 +101,42 @@ type SuiGasData struct {
 	Budget string `json:"budget"`
 }
 
+const (
+	ObjectChangeTypePublished   = "published"
+	ObjectChangeTypeTransferred = "transferred"
+	ObjectChangeTypeMutated     = "mutated"
+	ObjectChangeTypeDeleted     = "deleted"
+	ObjectChangeTypeWrapped     = "wrapped"
+	ObjectChangeTypeCreated     = "created"
+)
+
 type SuiObjectChangePublished struct {
 	Type      string   `json:"type"`
 	PackageId string   `json:"packageId"`
-	Version   uint64   `json:"version"`
+	Version   string   `json:"version"`
 	Digest    string   `json:"digest"`
 	Modules   []string `json:"modules"`
 }
 
 type SuiObjectChangeTransferred struct {
-	Type       string      `json:"type"`
-	Sender     string      `json:"sender"`
-	Recipient  ObjectOwner `json:"recipient"`
-	ObjectType string      `json:"objectType"`
-	ObjectId   string      `json:"objectId"`
-	Version    uint64      `json:"version"`
-	Digest     string      `json:"digest"`
+	Type       string `json:"type"`
+	Sender     string `json:"sender"`
+	Recipient  Owner  `json:"recipient"`
+	ObjectType string `json:"objectType"`
+	ObjectId   string `json:"objectId"`
+	Version    string `json:"version"`
+	Digest     string `json:"digest"`
 }
 
 type SuiObjectChangeMutated struct {
-	Type            string      `json:"type"`
-	Sender          string      `json:"sender"`
-	Owner           ObjectOwner `json:"owner"`
-	ObjectType      string      `json:"objectType"`
-	ObjectId        string      `json:"objectId"`
-	Version         uint64      `json:"version"`
-	PreviousVersion uint64      `json:"previousVersion"`
-	Digest          string      `json:"digest"`
+	Type            string `json:"type"`
+	Sender          string `json:"sender"`
+	Owner           Owner  `json:"owner"`
+	ObjectType      string `json:"objectType"`
+	ObjectId        string `json:"objectId"`
+	Version         string `json:"version"`
+	PreviousVersion string `json:"previousVersion"`
+	Digest          string `json:"digest"`
 }
 
 type SuiObjectChangeDeleted struct {
@@ -128,7 +144,7 @@ type SuiObjectChangeDeleted struct {
 	Sender     string `json:"sender"`
 	ObjectType string `json:"objectType"`
 	ObjectId   string `json:"objectId"`
-	Version    uint64 `json:"version"`
+	Version    string `json:"version"`
 }
 
 type SuiObjectChangeWrapped struct {
@@ -136,21 +152,21 @@ type SuiObjectChangeWrapped struct {
 	Sender     string `json:"sender"`
 	ObjectType string `json:"objectType"`
 	ObjectId   string `json:"objectId"`
-	Version    uint64 `json:"version"`
+	Version    string `json:"version"`
 }
 
 type SuiObjectChangeCreated struct {
-	Type       string      `json:"type"`
-	Sender     string      `json:"sender"`
-	Owner      ObjectOwner `json:"owner"`
-	ObjectType string      `json:"objectType"`
-	ObjectId   string      `json:"objectId"`
-	Version    uint64      `json:"version"`
-	Digest     string      `json:"digest"`
+	Type       string `json:"type"`
+	Sender     string `json:"sender"`
+	Owner      Owner  `json:"owner"`
+	ObjectType string `json:"objectType"`
+	ObjectId   string `json:"objectId"`
+	Version    string `json:"version"`
+	Digest     string `json:"digest"`
 }
 
 type OwnedObjectRef struct {
-	Owner     interface{}  `json:"owner"`
+	Owner     Owner        `json:"owner"`
 	Reference SuiObjectRef `json:"reference"`
 }
 
@@ -159,15 +175,49 @@ type SuiEffects struct {
 	Status             ExecutionStatus      `json:"status"`
 	ExecutedEpoch      string               `json:"executedEpoch"`
 	GasUsed            GasCostSummary       `json:"gasUsed"`
-	ModifiedAtVersions []ModifiedAtVersions `json:"modifiedAtVersions"`
-	SharedObjects      []SuiObjectRef       `json:"sharedObjects"`
+	ModifiedAtVersions []ModifiedAtVersions `json:"modifiedAtVersions,omitempty"`
+	SharedObjects      []SuiObjectRef       `json:"sharedObjects,omitempty"`
 	TransactionDigest  string               `json:"transactionDigest"`
-	Created            []OwnedObjectRef     `json:"created"`
-	Mutated            []OwnedObjectRef     `json:"mutated"`
-	Deleted            []SuiObjectRef       `json:"deleted"`
-	GasObject          OwnedObjectRef       `json:"gasObject"`
-	EventsDigest       string               `json:"eventsDigest"`
-	Dependencies       []string             `json:"dependencies"`
+	Created            []OwnedObjectRef     `json:"created,omitempty"`
+	Mutated            []OwnedObjectRef     `json:"mutated,omitempty"`
+	// Unwrapped are the objects taken out of other objects, they are owned again
+	Unwrapped []OwnedObjectRef `json:"unwrapped,omitempty"`
+	Deleted   []SuiObjectRef   `json:"deleted,omitempty"`
+	// UnwrappedThenDeleted are the objects taken out of other objects and deleted
+	UnwrappedThenDeleted []SuiObjectRef `json:"unwrappedThenDeleted,omitempty"`
+	// Wrapped are the objects put in other objects
+	Wrapped      []SuiObjectRef `json:"wrapped,omitempty"`
+	GasObject    OwnedObjectRef `json:"gasObject"`
+	EventsDigest string         `json:"eventsDigest,omitempty"`
+	Dependencies []string       `json:"dependencies,omitempty"`
+	// AbortError is the Move abort failing the transaction
+	AbortError *SuiMoveAbort `json:"abortError,omitempty"`
+	Extra      ExtraFields   `json:"-"`
+}
+
+func (e SuiEffects) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(e, e.Extra)
+}
+
+func (e *SuiEffects) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, e, &e.Extra)
+}
+
+// SuiMoveAbort is where a Move function aborted, and its abort code.
+type SuiMoveAbort struct {
+	ModuleId  *string     `json:"module_id,omitempty"`
+	Function  *string     `json:"function,omitempty"`
+	Line      *uint16     `json:"line,omitempty"`
+	ErrorCode *string     `json:"error_code,omitempty"`
+	Extra     ExtraFields `json:"-"`
+}
+
+func (a SuiMoveAbort) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(a, a.Extra)
+}
+
+func (a *SuiMoveAbort) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, a, &a.Extra)
 }
 
 type ExecutionStatus struct {
@@ -199,72 +249,113 @@ type SuiTransactionBlockResponse struct {
 	Checkpoint              string              `json:"checkpoint,omitempty"`
 	ConfirmedLocalExecution bool                `json:"confirmedLocalExecution,omitempty"`
 	Results                 json.RawMessage     `json:"results,omitempty"`
+	Errors                  []string            `json:"errors,omitempty"`
+	Extra                   ExtraFields         `json:"-"`
+}
+
+func (r SuiTransactionBlockResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(r, r.Extra)
+}
+
+func (r *SuiTransactionBlockResponse) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, r, &r.Extra)
 }
 
 func (o ObjectChange) GetObjectChangeAddressOwner() string {
-	b, err := json.Marshal(o.Owner)
-	if err != nil {
-		return ""
-	}
+	address, _ := o.Owner.AddressOwner()
+	return address
+}
+func (o ObjectChange) GetObjectChangeObjectOwner() string {
+	objectId, _ := o.Owner.ObjectOwner()
+	return objectId
+}
+func (o ObjectChange) GetObjectOwnerShare() ObjectShare {
+	version, _ := o.Owner.Shared()
+	return ObjectShare{InitialSharedVersion: version}
+}
+
+// ObjectChange is a change of an object by a transaction. Its Type is one of the ObjectChangeType
+// constants, whose accessor returns the fields of the change.
+type ObjectChange struct {
+	Type   string `json:"type"`
+	Sender string `json:"sender,omitempty"`
+	// Owner is the owner of a created or a mutated object
+	Owner Owner `json:"owner,omitzero"`
+	// Recipient is the owner of a transferred object
+	Recipient       Owner       `json:"recipient,omitzero"`
+	ObjectType      string      `json:"objectType,omitempty"`
+	ObjectId        string      `json:"objectId,omitempty"`
+	PackageId       string      `json:"packageId,omitempty"`
+	Modules         []string    `json:"modules,omitempty"`
+	Version         string      `json:"version"`
+	PreviousVersion string      `json:"previousVersion,omitempty"`
+	Digest          string      `json:"digest,omitempty"`
+	Extra           ExtraFields `json:"-"`
+}
+
+func (o ObjectChange) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(o, o.Extra)
+}
+
+func (o *ObjectChange) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, o, &o.Extra)
+}
 
-	if gjson.ParseBytes(b).IsObject() {
-		var owner ObjectOwner
-		err = json.Unmarshal(b, &owner)
-		if err == nil {
-			return owner.AddressOwner
-		}
+func (o ObjectChange) Published() (SuiObjectChangePublished, bool) {
+	if o.Type != ObjectChangeTypePublished {
+		return SuiObjectChangePublished{}, false
 	}
-	return ""
+	return SuiObjectChangePublished{Type: o.Type, PackageId: o.PackageId, Version: o.Version, Digest: o.Digest, Modules: o.Modules}, true
 }
-func (o ObjectChange) GetObjectChangeObjectOwner() string {
-	b, err := json.Marshal(o.Owner)
-	if err != nil {
-		return ""
+
+func (o ObjectChange) Transferred() (SuiObjectChangeTransferred, bool) {
+	if o.Type != ObjectChangeTypeTransferred {
+		return SuiObjectChangeTransferred{}, false
 	}
+	return SuiObjectChangeTransferred{Type: o.Type, Sender: o.Sender, Recipient: o.Recipient, ObjectType: o.ObjectType, ObjectId: o.ObjectId, Version: o.Version, Digest: o.Digest}, true
+}
 
-	if gjson.ParseBytes(b).IsObject() {
-		var owner ObjectOwner
-		err = json.Unmarshal(b, &owner)
-		if err == nil {
-			return owner.ObjectOwner
-		}
+func (o ObjectChange) Mutated() (SuiObjectChangeMutated, bool) {
+	if o.Type != ObjectChangeTypeMutated {
+		return SuiObjectChangeMutated{}, false
 	}
-	return ""
+	return SuiObjectChangeMutated{Type: o.Type, Sender: o.Sender, Owner: o.Owner, ObjectType: o.ObjectType, ObjectId: o.ObjectId, Version: o.Version, PreviousVersion: o.PreviousVersion, Digest: o.Digest}, true
 }
-func (o ObjectChange) GetObjectOwnerShare() ObjectShare {
-	var share ObjectShare
-	b, err := json.Marshal(o.Owner)
-	if err != nil {
-		return share
+
+func (o ObjectChange) Deleted() (SuiObjectChangeDeleted, bool) {
+	if o.Type != ObjectChangeTypeDeleted {
+		return SuiObjectChangeDeleted{}, false
 	}
+	return SuiObjectChangeDeleted{Type: o.Type, Sender: o.Sender, ObjectType: o.ObjectType, ObjectId: o.ObjectId, Version: o.Version}, true
+}
 
-	if gjson.ParseBytes(b).IsObject() {
-		var owner ObjectOwner
-		err = json.Unmarshal(b, &owner)
-		if err == nil {
-			return owner.Shared
-		}
+func (o ObjectChange) Wrapped() (SuiObjectChangeWrapped, bool) {
+	if o.Type != ObjectChangeTypeWrapped {
+		return SuiObjectChangeWrapped{}, false
 	}
-	return share
+	return SuiObjectChangeWrapped{Type: o.Type, Sender: o.Sender, ObjectType: o.ObjectType, ObjectId: o.ObjectId, Version: o.Version}, true
 }
 
-type ObjectChange struct {
-	Type            string      `json:"type"`
-	Sender          string      `json:"sender"`
-	Owner           interface{} `json:"owner"`
-	ObjectType      string      `json:"objectType"`
-	ObjectId        string      `json:"objectId"`
-	PackageId       string      `json:"packageId"`
-	Modules         []string    `json:"modules"`
-	Version         string      `json:"version"`
-	PreviousVersion string      `json:"previousVersion,omitempty"`
-	Digest          string      `json:"digest"`
+func (o ObjectChange) Created() (SuiObjectChangeCreated, bool) {
+	if o.Type != ObjectChangeTypeCreated {
+		return SuiObjectChangeCreated{}, false
+	}
+	return SuiObjectChangeCreated{Type: o.Type, Sender: o.Sender, Owner: o.Owner, ObjectType: o.ObjectType, ObjectId: o.ObjectId, Version: o.Version, Digest: o.Digest}, true
 }
 
 type BalanceChanges struct {
-	Owner    json.RawMessage `json:"owner"`
-	CoinType string          `json:"coinType"`
-	Amount   string          `json:"amount"`
+	Owner    Owner       `json:"owner"`
+	CoinType string      `json:"coinType"`
+	Amount   string      `json:"amount"`
+	Extra    ExtraFields `json:"-"`
+}
+
+func (b BalanceChanges) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(b, b.Extra)
+}
+
+func (b *BalanceChanges) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, b, &b.Extra)
 }
 
 type IOwner interface {
@@ -277,24 +368,20 @@ func (o OwnerString) GetBalanceChangeOwner() string {
 	return string(o)
 }
 
+// Deprecated: use [Owner].
 type BalanceChangeOwner struct {
 	AddressOwner string `json:"AddressOwner"`
 	ObjectOwner  string `json:"ObjectOwner"`
 }
 
+// GetBalanceChangeOwner returns the address owner, "Immutable" for an immutable owner, or an
+// empty string.
 func (o BalanceChanges) GetBalanceChangeOwner() string {
-	var addressOwner BalanceChangeOwner
-	if err := json.Unmarshal(o.Owner, &addressOwner); err == nil {
-		if addressOwner.AddressOwner != "" {
-			return addressOwner.AddressOwner
-		}
+	if address, ok := o.Owner.AddressOwner(); ok {
+		return address
 	}
-
-	var immutableOwner string
-	if err := json.Unmarshal(o.Owner, &immutableOwner); err == nil {
-		if immutableOwner == "Immutable" {
-			return "Immutable"
-		}
+	if o.Owner.IsImmutable() {
+		return OwnerKindImmutable.String()
 	}
 	return ""
 }
@@ -314,28 +401,38 @@ type SuiTransactionBlockResponseQuery struct {
 type TransactionFilter map[string]interface{}
 
 // TransactionFilterByFromAddress is a filter for from address
+//
+// Deprecated: use filter.Transaction.FromAddress of the filter package.
 type TransactionFilterByFromAddress struct {
 	FromAddress string `json:"FromAddress"`
 }
 
 // TransactionFilterByToAddress is a filter for to address
+//
+// Deprecated: use filter.Transaction.ToAddress of the filter package.
 type TransactionFilterByToAddress struct {
 	ToAddress string `json:"ToAddress"`
 }
 
 // TransactionFilterByInputObject is a filter for input objects
+//
+// Deprecated: use filter.Transaction.InputObject of the filter package.
 type TransactionFilterByInputObject struct {
 	// InputObject is the id of the object
 	InputObject string `json:"InputObject"`
 }
 
 // TransactionFilterByChangedObjectFilter is a filter for changed objects
+//
+// Deprecated: use filter.Transaction.ChangedObject of the filter package.
 type TransactionFilterByChangedObjectFilter struct {
 	// ChangedObject is a filter for changed objects
 	ChangedObject string `json:"ChangedObject"`
 }
 
 // TransactionFilterByMoveFunction is a filter for move functions
+//
+// Deprecated: use filter.Transaction.MoveFunction of the filter package.
 type TransactionFilterByMoveFunction struct {
 	MoveFunction MoveFunction `json:"MoveFunction"`
 }
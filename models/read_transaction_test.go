@@ -0,0 +1,208 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// transactionBlockResponse has the shape of a sui_getTransactionBlock response with all the
+// options, with fields and variants this version doesn't know.
+const transactionBlockResponse = `{
+	"digest": "7h7bfWSkpCAHxnSAFBFmyrSnKPBMiMs3gZ8hpjmeEPVd",
+	"transaction": {
+		"data": {
+			"messageVersion": "v1",
+			"transaction": {
+				"kind": "ProgrammableTransaction",
+				"inputs": [
+					{"type": "pure", "valueType": "u64", "value": "1000"},
+					{"type": "pure", "valueType": "address", "value": "0x5"},
+					{"type": "object", "objectType": "sharedObject", "objectId": "0x6", "initialSharedVersion": "1", "mutable": false},
+					{"type": "object", "objectType": "immOrOwnedObject", "objectId": "0x7", "version": "12", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"},
+					{"type": "object", "objectType": "receiving", "objectId": "0x8", "version": "3", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"},
+					{"type": "funds", "reservation": {"amount": "5"}}
+				],
+				"transactions": [
+					{"SplitCoins": ["GasCoin", [{"Input": 0}]]},
+					{"MoveCall": {"package": "0x2", "module": "coin", "function": "value", "type_arguments": ["0x2::sui::SUI"], "arguments": [{"NestedResult": [0, 0]}, {"Input": 2}]}},
+					{"MergeCoins": [{"Input": 3}, [{"Result": 1}]]},
+					{"MakeMoveVec": [null, [{"Input": 3}, {"Input": 4}]]},
+					{"MakeMoveVec": ["u64", []]},
+					{"Publish": ["0x1", "0x2"]},
+					{"Upgrade": [["0x1"], "0x9", {"Result": 5}]},
+					{"TransferObjects": [[{"NestedResult": [0, 0]}, {"Unknown": 1}], {"Input": 1}]},
+					{"Reserve": [{"Input": 5}]},
+					{"Upgrade": [["0x1"], "0x9", {"Result": 5}, "0xa"]},
+					{"MakeMoveVec": [null, [], 1]}
+				]
+			},
+			"sender": "0x5",
+			"gasData": {"payment": [{"objectId": "0x7", "version": 12, "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"}], "owner": "0x5", "price": "750", "budget": "5000000"},
+			"expiration": {"Epoch": 3}
+		},
+		"txSignatures": ["AA=="]
+	},
+	"effects": {
+		"messageVersion": "v1",
+		"status": {"status": "failure", "error": "MoveAbort"},
+		"executedEpoch": "2",
+		"gasUsed": {"computationCost": "750000", "storageCost": "0", "storageRebate": "0", "nonRefundableStorageFee": "0"},
+		"modifiedAtVersions": [{"objectId": "0x7", "sequenceNumber": "12"}],
+		"transactionDigest": "7h7bfWSkpCAHxnSAFBFmyrSnKPBMiMs3gZ8hpjmeEPVd",
+		"unwrapped": [{"owner": {"AddressOwner": "0x5"}, "reference": {"objectId": "0xa", "version": 13, "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"}}],
+		"wrapped": [{"objectId": "0xb", "version": 13, "digest": "7gyGAp71YXQRoxmFBaHxofQXAipvgHyBKPyxmdSJxyvz"}],
+		"gasObject": {"owner": {"AddressOwner": "0x5"}, "reference": {"objectId": "0x7", "version": 13, "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"}},
+		"abortError": {"module_id": "0x2::coin", "function": "value", "line": 7, "error_code": "4"},
+		"accumulatorEvents": []
+	},
+	"events": [{
+		"id": {"txDigest": "7h7bfWSkpCAHxnSAFBFmyrSnKPBMiMs3gZ8hpjmeEPVd", "eventSeq": "0"},
+		"packageId": "0x2",
+		"transactionModule": "coin",
+		"sender": "0x5",
+		"type": "0x2::coin::Event",
+		"parsedJson": {"value": "1"},
+		"bcs": "AQ==",
+		"bcsEncoding": "base64",
+		"timestampMs": "1"
+	}],
+	"objectChanges": [
+		{"type": "published", "packageId": "0x9", "version": "1", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p", "modules": ["m"]},
+		{"type": "transferred", "sender": "0x5", "recipient": {"AddressOwner": "0xc"}, "objectType": "0x2::coin::Coin<0x2::sui::SUI>", "objectId": "0xd", "version": "13", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"},
+		{"type": "mutated", "sender": "0x5", "owner": {"AddressOwner": "0x5"}, "objectType": "0x2::coin::Coin<0x2::sui::SUI>", "objectId": "0x7", "version": "13", "previousVersion": "12", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"},
+		{"type": "deleted", "sender": "0x5", "objectType": "0x2::m::T", "objectId": "0xe", "version": "13"},
+		{"type": "wrapped", "sender": "0x5", "objectType": "0x2::m::T", "objectId": "0xb", "version": "13"},
+		{"type": "created", "sender": "0x5", "owner": "Immutable", "objectType": "0x2::m::T", "objectId": "0xf", "version": "13", "digest": "8cKhTU3D4ZLgSQGcCW8tVCNcXiHRbYoW1dA9pDvTrV2p"},
+		{"type": "frozen", "objectId": "0x10", "version": "13"}
+	],
+	"balanceChanges": [{"owner": {"AddressOwner": "0x5"}, "coinType": "0x2::sui::SUI", "amount": "-750000", "reason": "gas"}],
+	"timestampMs": "1700000000000",
+	"checkpoint": "42",
+	"rawEffects": [1, 2]
+}`
+
+func TestSuiTransactionBlockResponseJSON(t *testing.T) {
+	var rsp SuiTransactionBlockResponse
+	require.NoError(t, json.Unmarshal([]byte(transactionBlockResponse), &rsp))
+
+	kind := rsp.Transaction.Data.Transaction
+	require.Len(t, kind.Inputs, 6)
+	require.True(t, kind.Inputs[0].IsPure())
+	require.Equal(t, "u64", *kind.Inputs[0].ValueType)
+	require.JSONEq(t, `"1000"`, string(kind.Inputs[0].Value))
+	require.True(t, kind.Inputs[2].IsObject())
+	require.Equal(t, SuiObjectArgSharedObject, kind.Inputs[2].ObjectType)
+	require.Equal(t, "1", kind.Inputs[2].InitialSharedVersion)
+	require.False(t, *kind.Inputs[2].Mutable)
+	require.Equal(t, SuiObjectArgImmOrOwnedObject, kind.Inputs[3].ObjectType)
+	require.Equal(t, "12", kind.Inputs[3].Version)
+	require.Equal(t, SuiObjectArgReceiving, kind.Inputs[4].ObjectType)
+	require.Equal(t, "funds", kind.Inputs[5].Type)
+	require.Equal(t, ExtraFields{"reservation": json.RawMessage(`{"amount": "5"}`)}, kind.Inputs[5].Extra)
+
+	input := func(i uint16) SuiArgument { return SuiArgument{Input: &i} }
+	result := func(i uint16) SuiArgument { return SuiArgument{Result: &i} }
+	u64 := "u64"
+	commands := kind.Transactions
+	require.Len(t, commands, 11)
+	require.Equal(t, &SplitCoinsSuiTransaction{Coin: SuiArgument{GasCoin: true}, Amounts: []SuiArgument{input(0)}}, commands[0].SplitCoins)
+	require.Equal(t, &MoveCallSuiTransaction{
+		Package:       "0x2",
+		Module:        "coin",
+		Function:      "value",
+		TypeArguments: []string{"0x2::sui::SUI"},
+		Arguments:     []SuiArgument{{NestedResult: &SuiNestedResult{}}, input(2)},
+	}, MoveCall(commands[1]))
+	require.Equal(t, &MergeCoinsSuiTransaction{Destination: input(3), Sources: []SuiArgument{result(1)}}, commands[2].MergeCoins)
+	require.Equal(t, &MakeMoveVecSuiTransaction{Elements: []SuiArgument{input(3), input(4)}}, commands[3].MakeMoveVec)
+	require.Equal(t, &MakeMoveVecSuiTransaction{Type: &u64, Elements: []SuiArgument{}}, commands[4].MakeMoveVec)
+	require.Equal(t, &PublishSuiTransaction{Dependencies: []string{"0x1", "0x2"}}, commands[5].Publish)
+	require.Equal(t, &UpgradeSuiTransaction{Dependencies: []string{"0x1"}, Package: "0x9", Ticket: result(5)}, commands[6].Upgrade)
+	require.Equal(t, &TransferObjectsSuiTransaction{
+		Objects: []SuiArgument{{NestedResult: &SuiNestedResult{}}, {Unknown: json.RawMessage(`{"Unknown": 1}`)}},
+		Address: input(1),
+	}, commands[7].TransferObjects)
+	require.Equal(t, json.RawMessage(`{"Reserve": [{"Input": 5}]}`), commands[8].Unknown)
+	require.Nil(t, MoveCall(commands[8]))
+	require.Equal(t, SuiTransactionEnum{Unknown: json.RawMessage(`{"Upgrade": [["0x1"], "0x9", {"Result": 5}, "0xa"]}`)}, commands[9])
+	require.Equal(t, SuiTransactionEnum{Unknown: json.RawMessage(`{"MakeMoveVec": [null, [], 1]}`)}, commands[10])
+	require.Equal(t, ExtraFields{"expiration": json.RawMessage(`{"Epoch": 3}`)}, rsp.Transaction.Data.Extra)
+
+	effects := rsp.Effects
+	require.Equal(t, "0xa", effects.Unwrapped[0].Reference.ObjectId)
+	require.Equal(t, "0xb", effects.Wrapped[0].ObjectId)
+	require.Equal(t, "0x2::coin", *effects.AbortError.ModuleId)
+	require.Equal(t, uint16(7), *effects.AbortError.Line)
+	require.Equal(t, "4", *effects.AbortError.ErrorCode)
+	require.Contains(t, effects.Extra, "accumulatorEvents")
+
+	require.Equal(t, map[string]interface{}{"value": "1"}, rsp.Events[0].ParsedJson)
+
+	changes := rsp.ObjectChanges
+	published, ok := changes[0].Published()
+	require.True(t, ok)
+	require.Equal(t, []string{"m"}, published.Modules)
+	transferred, ok := changes[1].Transferred()
+	require.True(t, ok)
+	require.Equal(t, NewAddressOwner("0xc"), transferred.Recipient)
+	mutated, ok := changes[2].Mutated()
+	require.True(t, ok)
+	require.Equal(t, "12", mutated.PreviousVersion)
+	deleted, ok := changes[3].Deleted()
+	require.True(t, ok)
+	require.Equal(t, "0xe", deleted.ObjectId)
+	wrapped, ok := changes[4].Wrapped()
+	require.True(t, ok)
+	require.Equal(t, "0xb", wrapped.ObjectId)
+	created, ok := changes[5].Created()
+	require.True(t, ok)
+	require.True(t, created.Owner.IsImmutable())
+	_, ok = changes[5].Mutated()
+	require.False(t, ok)
+	require.Equal(t, "frozen", changes[6].Type)
+
+	require.Equal(t, "-750000", rsp.BalanceChanges[0].Amount)
+	require.Equal(t, ExtraFields{"reason": json.RawMessage(`"gas"`)}, rsp.BalanceChanges[0].Extra)
+	require.Equal(t, ExtraFields{"rawEffects": json.RawMessage(`[1, 2]`)}, rsp.Extra)
+
+	// the unknown fields and variants are encoded back
+	encoded, err := json.Marshal(rsp)
+	require.NoError(t, err)
+	require.JSONEq(t, transactionBlockResponse, string(encoded))
+}
+
+func TestSuiArgumentJSON(t *testing.T) {
+	index := uint16(3)
+	tests := []struct {
+		name     string
+		json     string
+		argument SuiArgument
+	}{
+		{name: "gas coin", json: `"GasCoin"`, argument: SuiArgument{GasCoin: true}},
+		{name: "input", json: `{"Input":3}`, argument: SuiArgument{Input: &index}},
+		{name: "result", json: `{"Result":3}`, argument: SuiArgument{Result: &index}},
+		{name: "nested result", json: `{"NestedResult":[3,1]}`, argument: SuiArgument{NestedResult: &SuiNestedResult{Index: 3, ResultIndex: 1}}},
+		{name: "unknown name", json: `"Balance"`, argument: SuiArgument{Unknown: json.RawMessage(`"Balance"`)}},
+		{name: "unknown variant", json: `{"Balance":1}`, argument: SuiArgument{Unknown: json.RawMessage(`{"Balance":1}`)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var argument SuiArgument
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &argument))
+			require.Equal(t, tt.argument, argument)
+
+			encoded, err := json.Marshal(argument)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.json, string(encoded))
+		})
+	}
+
+	var argument SuiArgument
+	require.Error(t, json.Unmarshal([]byte(`{"NestedResult":[3]}`), &argument))
+	var command SuiTransactionEnum
+	require.NoError(t, json.Unmarshal([]byte(`{"SplitCoins":["GasCoin"]}`), &command))
+	require.Equal(t, SuiTransactionEnum{Unknown: json.RawMessage(`{"SplitCoins":["GasCoin"]}`)}, command)
+}
@@ -138,10 +138,15 @@ func messageWithIntent(message []byte) []byte {
 }
 
 func ToSerializedSignature(signature, pubKey []byte) string {
+	return ToSerializedSignatureWithFlag(byte(SigFlagEd25519), signature, pubKey)
+}
+
+// ToSerializedSignatureWithFlag serializes `flag || signature || pubkey` in base64.
+func ToSerializedSignatureWithFlag(flag byte, signature, pubKey []byte) string {
 	signatureLen := len(signature)
 	pubKeyLen := len(pubKey)
 	serializedSignature := make([]byte, 1+signatureLen+pubKeyLen)
-	serializedSignature[0] = byte(SigFlagEd25519)
+	serializedSignature[0] = flag
 	copy(serializedSignature[1:], signature)
 	copy(serializedSignature[1+signatureLen:], pubKey)
 	return base64.StdEncoding.EncodeToString(serializedSignature)
@@ -186,14 +191,32 @@ func parseSignatureScheme(scheme byte) string {
 }
 
 func VerifyPersonalMessage(message string, signature string) (signer string, pass bool, err error) {
-	b64Message := base64.StdEncoding.EncodeToString([]byte(message))
-	return VerifyMessage(b64Message, signature, constant.PersonalMessageIntentScope)
+	return VerifyIntentMessage(NewPersonalMessageIntentMessage([]byte(message)), signature)
 }
 
+// VerifyTransaction verifies an ed25519 signature over the base64 TransactionData bytes, which
+// are signed as is, without BCS prefixing.
 func VerifyTransaction(b64Message string, signature string) (signer string, pass bool, err error) {
-	return VerifyMessage(b64Message, signature, constant.TransactionDataIntentScope)
+	txBytes, err := base64.StdEncoding.DecodeString(b64Message)
+	if err != nil {
+		return "", false, err
+	}
+	return VerifyIntentMessage(NewTransactionDataIntentMessage(txBytes), signature)
+}
+
+// VerifyIntentMessage verifies an ed25519 serialized signature over the intent message.
+func VerifyIntentMessage(message IntentMessage, signature string) (signer string, pass bool, err error) {
+	serializedSignature, err := FromSerializedSignature(signature)
+	if err != nil {
+		return "", false, err
+	}
+	digest := message.Digest()
+
+	pass = ed25519.Verify(serializedSignature.PubKey[:], digest[:], serializedSignature.Signature)
+	return Ed25519PublicKeyToSuiAddress(serializedSignature.PubKey), pass, nil
 }
 
+// VerifyMessage verifies an ed25519 signature over the BCS serialized base64 message.
 func VerifyMessage(message, signature string, scope constant.IntentScope) (signer string, pass bool, err error) {
 	b64Bytes, _ := base64.StdEncoding.DecodeString(message)
 
@@ -220,9 +243,5 @@ func VerifyMessage(message, signature string, scope constant.IntentScope) (signe
 }
 
 func Ed25519PublicKeyToSuiAddress(pubKey []byte) string {
-	newPubkey := []byte{byte(SigFlagEd25519)}
-	newPubkey = append(newPubkey, pubKey...)
-
-	addrBytes := blake2b.Sum256(newPubkey)
-	return fmt.Sprintf("0x%s", hex.EncodeToString(addrBytes[:])[:64])
+	return PublicKeyToSuiAddress(byte(SigFlagEd25519), pubKey)
 }
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+)
+
+// Signer signs intent message digests for a Sui address. The key may be held in process
+// memory, in a KMS or HSM, or by a remote signing service.
+type Signer interface {
+	// GetKeyScheme returns the signature scheme of the key.
+	GetKeyScheme() scheme.SignatureScheme
+	// GetPublicKey returns the raw public key, without the scheme flag.
+	GetPublicKey() []byte
+	// ToSuiAddress returns the address controlled by the key.
+	ToSuiAddress() string
+	// Sign signs the 32 bytes blake2b digest of an intent message. It returns the raw signature for
+	// ED25519, Secp256k1 and Secp256r1, and the full serialized signature for MultiSig, ZkLogin and Passkey.
+	Sign(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+// IntentSigner is implemented by signers that need the intent message itself rather than its
+// digest, e.g. remote signers enforcing policies on the intent scope.
+type IntentSigner interface {
+	Signer
+	// SignIntentMessage signs the intent message `scope || version || app_id || data`, and returns
+	// the signature in the same form as Sign.
+	SignIntentMessage(ctx context.Context, intentMessage []byte) ([]byte, error)
+}
+
+// SignWithIntent signs the intent message of data and returns the base64 serialized signature.
+func SignWithIntent(ctx context.Context, signer Signer, data []byte, scope constant.IntentScope) (string, error) {
+	return SignIntentMessage(ctx, signer, IntentMessage{Intent: NewIntent(scope), Value: data})
+}
+
+// SignTransactionData signs BCS serialized TransactionData bytes with the transaction intent.
+func SignTransactionData(ctx context.Context, signer Signer, txBytes []byte) (string, error) {
+	return SignIntentMessage(ctx, signer, NewTransactionDataIntentMessage(txBytes))
+}
+
+// SignPersonalMessage signs a personal message with the personal message intent, the message is
+// BCS serialized first as the Sui spec requires.
+func SignPersonalMessage(ctx context.Context, signer Signer, message []byte) (string, error) {
+	return SignIntentMessage(ctx, signer, NewPersonalMessageIntentMessage(message))
+}
+
+// SignIntentMessage signs the intent message and returns the base64 serialized signature.
+func SignIntentMessage(ctx context.Context, signer Signer, message IntentMessage) (string, error) {
+	if intentSigner, ok := signer.(IntentSigner); ok {
+		signature, err := intentSigner.SignIntentMessage(ctx, message.Bytes())
+		if err != nil {
+			return "", err
+		}
+		return SerializeSignature(signer.GetKeyScheme(), signature, signer.GetPublicKey()), nil
+	}
+
+	digest := message.Digest()
+	return SignDigest(ctx, signer, digest[:])
+}
+
+// SignDigest signs the intent message digest and returns the base64 serialized signature.
+func SignDigest(ctx context.Context, signer Signer, digest []byte) (string, error) {
+	signature, err := signer.Sign(ctx, digest)
+	if err != nil {
+		return "", err
+	}
+
+	return SerializeSignature(signer.GetKeyScheme(), signature, signer.GetPublicKey()), nil
+}
+
+// SerializeSignature serializes a signature produced by a Signer in base64.
+func SerializeSignature(signatureScheme scheme.SignatureScheme, signature []byte, pubKey []byte) string {
+	switch signatureScheme {
+	case scheme.MultiSig, scheme.ZkLogin, scheme.Passkey:
+		return base64.StdEncoding.EncodeToString(signature)
+	default:
+		return ToSerializedSignatureWithFlag(scheme.SignatureSchemeToFlag[signatureScheme], signature, pubKey)
+	}
+}
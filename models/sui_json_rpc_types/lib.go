@@ -142,6 +142,7 @@ type SuiMoveNormalizedModule struct {
 	Name              string                 `json:"name"`
 	Friends           []SuiMoveModuleId      `json:"friends"`
 	Structs           map[string]interface{} `json:"structs"`
+	Enums             map[string]interface{} `json:"enums,omitempty"`
 	ExposedFunctions  map[string]interface{} `json:"exposedFunctions"`
 }
 
@@ -0,0 +1,298 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// SuiArgument is an argument of a command of a programmable transaction, one of its fields is set.
+// It is encoded in JSON as `"GasCoin"`, `{"Input": 0}`, `{"Result": 1}` or
+// `{"NestedResult": [1, 0]}`. A variant this version doesn't know is kept in Unknown.
+type SuiArgument struct {
+	// GasCoin is the coin paying the gas
+	GasCoin bool `json:"-"`
+	// Input is the index of an input of the transaction
+	Input *uint16 `json:"Input,omitempty"`
+	// Result is the index of the command whose result is the argument
+	Result *uint16 `json:"Result,omitempty"`
+	// NestedResult is a result of a command returning several
+	NestedResult *SuiNestedResult `json:"NestedResult,omitempty"`
+	// Unknown is the JSON of a variant this version doesn't know
+	Unknown json.RawMessage `json:"-"`
+}
+
+// SuiNestedResult is encoded in JSON as `[index, resultIndex]`.
+type SuiNestedResult struct {
+	Index       uint16
+	ResultIndex uint16
+}
+
+const suiArgumentGasCoin = "GasCoin"
+
+func (a SuiArgument) MarshalJSON() ([]byte, error) {
+	type plain SuiArgument
+	switch {
+	case a.GasCoin:
+		return json.Marshal(suiArgumentGasCoin)
+	case a.Input == nil && a.Result == nil && a.NestedResult == nil && a.Unknown != nil:
+		return a.Unknown, nil
+	}
+	return json.Marshal(plain(a))
+}
+
+func (a *SuiArgument) UnmarshalJSON(data []byte) error {
+	type plain SuiArgument
+	*a = SuiArgument{}
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		if name == suiArgumentGasCoin {
+			a.GasCoin = true
+		} else {
+			a.Unknown = append(json.RawMessage(nil), data...)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(data, (*plain)(a)); err != nil {
+		return fmt.Errorf("invalid argument: %v", err)
+	}
+	if a.Input == nil && a.Result == nil && a.NestedResult == nil {
+		a.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (r SuiNestedResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]uint16{r.Index, r.ResultIndex})
+}
+
+func (r *SuiNestedResult) UnmarshalJSON(data []byte) error {
+	var result [2]uint16
+	if err := unmarshalTuple(data, &result[0], &result[1]); err != nil {
+		return fmt.Errorf("invalid nested result: %v", err)
+	}
+	r.Index, r.ResultIndex = result[0], result[1]
+	return nil
+}
+
+const (
+	SuiCallArgTypePure   = "pure"
+	SuiCallArgTypeObject = "object"
+)
+
+const (
+	SuiObjectArgImmOrOwnedObject = "immOrOwnedObject"
+	SuiObjectArgSharedObject     = "sharedObject"
+	SuiObjectArgReceiving        = "receiving"
+)
+
+// SuiCallArg is an input of a programmable transaction. Its Type is [SuiCallArgTypePure], with
+// the value and its Move type when the node knows it, or [SuiCallArgTypeObject], with the
+// ObjectType of the object argument and its reference.
+type SuiCallArg struct {
+	Type string `json:"type"`
+	// ValueType is the Move type of a pure value
+	ValueType *string `json:"valueType,omitempty"`
+	// Value is the JSON of a pure value
+	Value json.RawMessage `json:"value,omitempty"`
+	// ObjectType is [SuiObjectArgImmOrOwnedObject], [SuiObjectArgSharedObject] or
+	// [SuiObjectArgReceiving]
+	ObjectType string `json:"objectType,omitempty"`
+	ObjectId   string `json:"objectId,omitempty"`
+	// Version is the version of an owned or a receiving object
+	Version string `json:"version,omitempty"`
+	// Digest is the digest of an owned or a receiving object
+	Digest               string `json:"digest,omitempty"`
+	InitialSharedVersion string `json:"initialSharedVersion,omitempty"`
+	// Mutable tells whether a shared object is used mutably
+	Mutable *bool       `json:"mutable,omitempty"`
+	Extra   ExtraFields `json:"-"`
+}
+
+func (c SuiCallArg) IsPure() bool {
+	return c.Type == SuiCallArgTypePure
+}
+
+func (c SuiCallArg) IsObject() bool {
+	return c.Type == SuiCallArgTypeObject
+}
+
+func (c SuiCallArg) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(c, c.Extra)
+}
+
+func (c *SuiCallArg) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, c, &c.Extra)
+}
+
+// SuiTransactionEnum is a command of a programmable transaction, one of its fields is set. It is
+// encoded in JSON as an object with the name of the command, e.g. `{"SplitCoins": ["GasCoin",
+// [{"Input": 0}]]}`. A command this version doesn't know, or whose shape changed, is kept in
+// Unknown.
+type SuiTransactionEnum struct {
+	MoveCall        *MoveCallSuiTransaction        `json:"MoveCall,omitempty"`
+	TransferObjects *TransferObjectsSuiTransaction `json:"TransferObjects,omitempty"`
+	SplitCoins      *SplitCoinsSuiTransaction      `json:"SplitCoins,omitempty"`
+	MergeCoins      *MergeCoinsSuiTransaction      `json:"MergeCoins,omitempty"`
+	Publish         *PublishSuiTransaction         `json:"Publish,omitempty"`
+	Upgrade         *UpgradeSuiTransaction         `json:"Upgrade,omitempty"`
+	MakeMoveVec     *MakeMoveVecSuiTransaction     `json:"MakeMoveVec,omitempty"`
+	// Unknown is the JSON of a command this version doesn't know or can't decode
+	Unknown json.RawMessage `json:"-"`
+}
+
+func (t SuiTransactionEnum) isEmpty() bool {
+	return t.MoveCall == nil && t.TransferObjects == nil && t.SplitCoins == nil && t.MergeCoins == nil &&
+		t.Publish == nil && t.Upgrade == nil && t.MakeMoveVec == nil
+}
+
+func (t SuiTransactionEnum) MarshalJSON() ([]byte, error) {
+	type plain SuiTransactionEnum
+	if t.isEmpty() && t.Unknown != nil {
+		return t.Unknown, nil
+	}
+	return json.Marshal(plain(t))
+}
+
+func (t *SuiTransactionEnum) UnmarshalJSON(data []byte) error {
+	type plain SuiTransactionEnum
+	*t = SuiTransactionEnum{}
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil || t.isEmpty() {
+		// a command this version doesn't know, or a known command whose shape changed, doesn't
+		// fail the decoding of the whole response
+		*t = SuiTransactionEnum{Unknown: append(json.RawMessage(nil), data...)}
+	}
+	return nil
+}
+
+// MoveCallSuiTransaction calls a Move function.
+type MoveCallSuiTransaction struct {
+	Package       string        `json:"package"`
+	Module        string        `json:"module"`
+	Function      string        `json:"function"`
+	TypeArguments []string      `json:"type_arguments,omitempty"`
+	Arguments     []SuiArgument `json:"arguments,omitempty"`
+	Extra         ExtraFields   `json:"-"`
+}
+
+func (c MoveCallSuiTransaction) MarshalJSON() ([]byte, error) {
+	return marshalJSONWithExtra(c, c.Extra)
+}
+
+func (c *MoveCallSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONWithExtra(data, c, &c.Extra)
+}
+
+// TransferObjectsSuiTransaction sends objects to an address, it is encoded in JSON as
+// `[objects, address]`.
+type TransferObjectsSuiTransaction struct {
+	Objects []SuiArgument
+	Address SuiArgument
+}
+
+func (c TransferObjectsSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{nonNil(c.Objects), c.Address})
+}
+
+func (c *TransferObjectsSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &c.Objects, &c.Address)
+}
+
+// SplitCoinsSuiTransaction splits coins of the amounts from a coin, it is encoded in JSON as
+// `[coin, amounts]`.
+type SplitCoinsSuiTransaction struct {
+	Coin    SuiArgument
+	Amounts []SuiArgument
+}
+
+func (c SplitCoinsSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{c.Coin, nonNil(c.Amounts)})
+}
+
+func (c *SplitCoinsSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &c.Coin, &c.Amounts)
+}
+
+// MergeCoinsSuiTransaction merges coins into the destination coin, it is encoded in JSON as
+// `[destination, sources]`.
+type MergeCoinsSuiTransaction struct {
+	Destination SuiArgument
+	Sources     []SuiArgument
+}
+
+func (c MergeCoinsSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{c.Destination, nonNil(c.Sources)})
+}
+
+func (c *MergeCoinsSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &c.Destination, &c.Sources)
+}
+
+// PublishSuiTransaction publishes a package, it is encoded in JSON as the ids of its dependencies.
+type PublishSuiTransaction struct {
+	Dependencies []string
+}
+
+func (c PublishSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nonNil(c.Dependencies))
+}
+
+func (c *PublishSuiTransaction) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &c.Dependencies)
+}
+
+// UpgradeSuiTransaction upgrades a package with an upgrade ticket, it is encoded in JSON as
+// `[dependencies, package, ticket]`.
+type UpgradeSuiTransaction struct {
+	Dependencies []string
+	Package      string
+	Ticket       SuiArgument
+}
+
+func (c UpgradeSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{nonNil(c.Dependencies), c.Package, c.Ticket})
+}
+
+func (c *UpgradeSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &c.Dependencies, &c.Package, &c.Ticket)
+}
+
+// MakeMoveVecSuiTransaction makes a vector of the elements, it is encoded in JSON as
+// `[type, elements]`. The type is null when the node infers it from the elements.
+type MakeMoveVecSuiTransaction struct {
+	Type     *string
+	Elements []SuiArgument
+}
+
+func (c MakeMoveVecSuiTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{c.Type, nonNil(c.Elements)})
+}
+
+func (c *MakeMoveVecSuiTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &c.Type, &c.Elements)
+}
+
+// unmarshalTuple decodes a JSON array of len(values) elements into the values.
+func unmarshalTuple(data []byte, values ...any) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if len(elements) != len(values) {
+		return fmt.Errorf("expected %d elements, got %d in %s", len(values), len(elements), bytes.TrimSpace(data))
+	}
+	for i, element := range elements {
+		if err := json.Unmarshal(element, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nonNil encodes a nil slice as an empty JSON array.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -209,7 +209,9 @@ type SuiExecuteTransactionBlockRequest struct {
 type SignAndExecuteTransactionBlockRequest struct {
 	TxnMetaData TxnMetaData
 	// the address private key to sign the transaction
-	PriKey  ed25519.PrivateKey
+	PriKey ed25519.PrivateKey
+	// Signer signs the transaction instead of PriKey when set
+	Signer  Signer                     `json:"-"`
 	Options SuiTransactionBlockOptions `json:"options"`
 	// The optional enumeration values are: `WaitForEffectsCert`, or `WaitForLocalExecution`
 	RequestType string `json:"requestType"`
@@ -0,0 +1,272 @@
+package movebcs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// maxDepth bounds the nesting of decoded values, as the layouts come from the network.
+const maxDepth = 128
+
+var ErrTrailingBytes = errors.New("trailing bytes after the decoded value")
+
+// Decoder decodes BCS bytes into MoveValue trees, using layouts fetched by its resolver.
+type Decoder struct {
+	resolver LayoutResolver
+}
+
+// NewDecoder creates a decoder, the resolver is wrapped in a CachedResolver unless it is one.
+func NewDecoder(resolver LayoutResolver) *Decoder {
+	if _, ok := resolver.(*CachedResolver); !ok {
+		resolver = NewCachedResolver(resolver)
+	}
+	return &Decoder{resolver: resolver}
+}
+
+// Decode decodes the BCS bytes of a value of the type, all the bytes must be consumed.
+func (d *Decoder) Decode(ctx context.Context, typeTag *TypeTag, data []byte) (MoveValue, error) {
+	r := &reader{data: data}
+	value, err := d.decode(ctx, r, typeTag, 0)
+	if err != nil {
+		return nil, err
+	}
+	if r.pos != len(data) {
+		return nil, ErrTrailingBytes
+	}
+	return value, nil
+}
+
+// DecodeStruct decodes the BCS bytes of a struct, such as the contents of an object or an event.
+func (d *Decoder) DecodeStruct(ctx context.Context, structType string, data []byte) (*Struct, error) {
+	typeTag, err := ParseTypeTag(structType)
+	if err != nil {
+		return nil, err
+	}
+	value, err := d.Decode(ctx, typeTag, data)
+	if err != nil {
+		return nil, err
+	}
+	structValue, ok := value.(*Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", structType)
+	}
+	return structValue, nil
+}
+
+// DecodeObject decodes a Move object fetched with ShowBcs.
+func (d *Decoder) DecodeObject(ctx context.Context, object *models.SuiObjectData) (*Struct, error) {
+	if object == nil || object.Bcs == nil || object.Bcs.DataType != "moveObject" {
+		return nil, errors.New("object has no Move object bcs, it must be fetched with ShowBcs")
+	}
+	data, err := base64.StdEncoding.DecodeString(object.Bcs.BcsBytes)
+	if err != nil {
+		return nil, err
+	}
+	return d.DecodeStruct(ctx, object.Bcs.Type, data)
+}
+
+// DecodeEvent decodes the `bcs` field of an event, in base64 or in the legacy base58.
+func (d *Decoder) DecodeEvent(ctx context.Context, event *models.SuiEventResponse) (*Struct, error) {
+	var data []byte
+	var err error
+	if event.BcsEncoding == "base64" {
+		data, err = base64.StdEncoding.DecodeString(event.Bcs)
+	} else {
+		data, err = base58.Decode(event.Bcs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid event bcs: %v", err)
+	}
+	return d.DecodeStruct(ctx, event.Type, data)
+}
+
+func (d *Decoder) decode(ctx context.Context, r *reader, typeTag *TypeTag, depth int) (MoveValue, error) {
+	if depth > maxDepth {
+		return nil, errors.New("value is nested too deeply")
+	}
+
+	switch typeTag.Kind {
+	case BoolType:
+		b, err := r.read(1)
+		if err != nil {
+			return nil, err
+		}
+		if b[0] > 1 {
+			return nil, fmt.Errorf("invalid bool %d", b[0])
+		}
+		return Bool(b[0] == 1), nil
+	case U8Type:
+		b, err := r.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return U8(b[0]), nil
+	case U16Type:
+		b, err := r.read(2)
+		if err != nil {
+			return nil, err
+		}
+		return U16(binary.LittleEndian.Uint16(b)), nil
+	case U32Type:
+		b, err := r.read(4)
+		if err != nil {
+			return nil, err
+		}
+		return U32(binary.LittleEndian.Uint32(b)), nil
+	case U64Type:
+		b, err := r.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return U64(binary.LittleEndian.Uint64(b)), nil
+	case U128Type:
+		b, err := r.read(16)
+		if err != nil {
+			return nil, err
+		}
+		return U128{mystenbcs.U128{Int: littleEndianInt(b)}}, nil
+	case U256Type:
+		b, err := r.read(32)
+		if err != nil {
+			return nil, err
+		}
+		return U256{mystenbcs.U256{Int: littleEndianInt(b)}}, nil
+	case AddressType, SignerType:
+		b, err := r.read(32)
+		if err != nil {
+			return nil, err
+		}
+		var address Address
+		copy(address[:], b)
+		return address, nil
+	case VectorType:
+		length, err := r.readLength()
+		if err != nil {
+			return nil, err
+		}
+		vector := make(Vector, 0, length)
+		for i := 0; i < length; i++ {
+			elem, err := d.decode(ctx, r, typeTag.Elem, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			vector = append(vector, elem)
+		}
+		return vector, nil
+	case StructType:
+		return d.decodeDatatype(ctx, r, typeTag.Struct, depth)
+	case TypeParameterType:
+		return nil, fmt.Errorf("unsubstituted type parameter %d", typeTag.Index)
+	default:
+		return nil, fmt.Errorf("unknown type kind %d", typeTag.Kind)
+	}
+}
+
+func (d *Decoder) decodeDatatype(ctx context.Context, r *reader, structTag *StructTag, depth int) (MoveValue, error) {
+	datatype, err := d.resolver.ResolveDatatype(ctx, structTag.Address, structTag.Module, structTag.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %v", structTag.ID(), err)
+	}
+
+	if !datatype.IsEnum {
+		fields, err := d.decodeFields(ctx, r, datatype.Fields, structTag.TypeParams, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", structTag.ID(), err)
+		}
+		return &Struct{Type: structTag, Fields: fields}, nil
+	}
+
+	tag, err := r.readUleb128()
+	if err != nil {
+		return nil, err
+	}
+	index := int(tag)
+	if index >= len(datatype.Variants) {
+		return nil, fmt.Errorf("%s: invalid variant %d", structTag.ID(), index)
+	}
+	variant := datatype.Variants[index]
+	fields, err := d.decodeFields(ctx, r, variant.Fields, structTag.TypeParams, depth)
+	if err != nil {
+		return nil, fmt.Errorf("%s::%s: %v", structTag.ID(), variant.Name, err)
+	}
+	return &Variant{Type: structTag, Name: variant.Name, Index: index, Fields: fields}, nil
+}
+
+func (d *Decoder) decodeFields(ctx context.Context, r *reader, layouts []FieldLayout, typeArgs []*TypeTag, depth int) ([]Field, error) {
+	fields := make([]Field, 0, len(layouts))
+	for _, layout := range layouts {
+		fieldType, err := substitute(layout.Type, typeArgs)
+		if err != nil {
+			return nil, err
+		}
+		value, err := d.decode(ctx, r, fieldType, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, Field{Name: layout.Name, Value: value})
+	}
+	return fields, nil
+}
+
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) read(n int) ([]byte, error) {
+	if n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("unexpected end of data at %d", r.pos)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// readUleb128 reads a canonical ULEB128 of at most 32 bits, a multi-byte encoding must not end
+// with a zero byte.
+func (r *reader) readUleb128() (uint32, error) {
+	value, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 || value > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid uleb128 at %d", r.pos)
+	}
+	if n > 1 && r.data[r.pos+n-1] == 0 {
+		return 0, fmt.Errorf("non-canonical uleb128 at %d", r.pos)
+	}
+	r.pos += n
+	return uint32(value), nil
+}
+
+// readLength reads the length of a vector, which is bounded by the remaining bytes since every
+// element takes at least one byte.
+func (r *reader) readLength() (int, error) {
+	offset := r.pos
+	length, err := r.readUleb128()
+	if err != nil {
+		return 0, err
+	}
+	if length > mystenbcs.MaxSequenceLength {
+		return 0, fmt.Errorf("%w %d at %d: %d", mystenbcs.ErrMaxSequenceLength, mystenbcs.MaxSequenceLength, offset, length)
+	}
+	if int(length) > len(r.data)-r.pos {
+		return 0, fmt.Errorf("invalid vector length %d at %d", length, r.pos)
+	}
+	return int(length), nil
+}
+
+func littleEndianInt(b []byte) *big.Int {
+	bigEndian := make([]byte, len(b))
+	for i := range b {
+		bigEndian[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(bigEndian)
+}
@@ -0,0 +1,131 @@
+package movebcs
+
+import (
+	"encoding/json"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ToJSON converts the value to the JSON of the node's event `parsedJson`. It isn't the JSON of an
+// object's `content.fields`, whose nested structs are wrapped in `{"type", "fields"}`:
+//   - u8, u16 and u32 are numbers, u64, u128 and u256 are strings
+//   - addresses are 0x prefixed 64 hex strings
+//   - structs are objects of their fields, enums are `{"type", "variant", "fields"}`
+//   - 0x1::string::String and 0x1::ascii::String are strings, 0x2::url::Url is its url
+//   - 0x2::object::ID is its address, 0x2::object::UID is `{"id": address}`
+//   - 0x2::balance::Balance is its value and 0x1::option::Option is its value or null
+func ToJSON(value MoveValue) interface{} {
+	switch v := value.(type) {
+	case Bool:
+		return bool(v)
+	case U8:
+		return uint32(v)
+	case U16:
+		return uint32(v)
+	case U32:
+		return uint32(v)
+	case U64:
+		return strconv.FormatUint(uint64(v), 10)
+	case U128:
+		return v.String()
+	case U256:
+		return v.String()
+	case Address:
+		return v.String()
+	case Vector:
+		elems := make([]interface{}, len(v))
+		for i, elem := range v {
+			elems[i] = ToJSON(elem)
+		}
+		return elems
+	case *Struct:
+		if converted, ok := convertSuiType(v); ok {
+			return converted
+		}
+		return fieldsToJSON(v.Fields)
+	case *Variant:
+		return map[string]interface{}{
+			"type":    v.Type.String(),
+			"variant": v.Name,
+			"fields":  fieldsToJSON(v.Fields),
+		}
+	default:
+		return nil
+	}
+}
+
+// MarshalJSON marshals the value as ToJSON.
+func MarshalJSON(value MoveValue) ([]byte, error) {
+	return json.Marshal(ToJSON(value))
+}
+
+func fieldsToJSON(fields []Field) map[string]interface{} {
+	object := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		object[field.Name] = ToJSON(field.Value)
+	}
+	return object
+}
+
+// convertSuiType converts the framework types the node shows as their content.
+func convertSuiType(s *Struct) (interface{}, bool) {
+	switch {
+	case s.Type.Is("0x1", "string", "String"), s.Type.Is("0x1", "ascii", "String"):
+		bytes, ok := byteVector(s.Field("bytes"))
+		if !ok || !utf8.Valid(bytes) {
+			return nil, false
+		}
+		return string(bytes), true
+	case s.Type.Is("0x2", "url", "Url"):
+		return convertField(s, "url")
+	case s.Type.Is("0x2", "object", "ID"):
+		return convertField(s, "bytes")
+	case s.Type.Is("0x2", "object", "UID"):
+		id, ok := s.Field("id").(*Struct)
+		if !ok {
+			return nil, false
+		}
+		bytes, ok := id.Field("bytes").(Address)
+		if !ok {
+			return nil, false
+		}
+		return map[string]interface{}{"id": bytes.String()}, true
+	case s.Type.Is("0x2", "balance", "Balance"):
+		return convertField(s, "value")
+	case s.Type.Is("0x1", "option", "Option"):
+		vec, ok := s.Field("vec").(Vector)
+		if !ok {
+			return nil, false
+		}
+		if len(vec) == 0 {
+			return nil, true
+		}
+		return ToJSON(vec[0]), true
+	default:
+		return nil, false
+	}
+}
+
+func convertField(s *Struct, name string) (interface{}, bool) {
+	value := s.Field(name)
+	if value == nil {
+		return nil, false
+	}
+	return ToJSON(value), true
+}
+
+func byteVector(value MoveValue) ([]byte, bool) {
+	vec, ok := value.(Vector)
+	if !ok {
+		return nil, false
+	}
+	bytes := make([]byte, len(vec))
+	for i, elem := range vec {
+		b, ok := elem.(U8)
+		if !ok {
+			return nil, false
+		}
+		bytes[i] = byte(b)
+	}
+	return bytes, true
+}
@@ -0,0 +1,255 @@
+package movebcs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/models/sui_json_rpc_types"
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+// Datatype is the layout of a Move struct or enum, field types may refer to its type parameters.
+type Datatype struct {
+	Address string
+	Module  string
+	Name    string
+	// IsEnum is set for enums, which have Variants instead of Fields.
+	IsEnum   bool
+	Fields   []FieldLayout
+	Variants []VariantLayout
+}
+
+type FieldLayout struct {
+	Name string
+	Type *TypeTag
+}
+
+type VariantLayout struct {
+	Name   string
+	Fields []FieldLayout
+}
+
+// DatatypeFromNormalizedStruct converts the JSON-RPC `sui_getNormalizedMoveStruct` response.
+func DatatypeFromNormalizedStruct(address, module, name string, normalized sui_json_rpc_types.SuiMoveNormalizedStruct) (*Datatype, error) {
+	normalizedAddress, err := normalizeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the fields are untyped in the response, so they are remarshaled
+	data, err := json.Marshal(normalized.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var fields []struct {
+		Name string          `json:"name"`
+		Type json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid normalized struct %s::%s::%s: %v", address, module, name, err)
+	}
+
+	datatype := &Datatype{Address: normalizedAddress, Module: module, Name: name}
+	for _, field := range fields {
+		fieldType, err := ParseNormalizedType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of field %s of %s::%s::%s: %v", field.Name, address, module, name, err)
+		}
+		datatype.Fields = append(datatype.Fields, FieldLayout{Name: field.Name, Type: fieldType})
+	}
+	return datatype, nil
+}
+
+// ParseNormalizedType parses a normalized type, a string for primitives such as `"U64"` or
+// an object such as `{"Vector": "U8"}`, `{"TypeParameter": 0}` or `{"Struct": {...}}`.
+func ParseNormalizedType(data json.RawMessage) (*TypeTag, error) {
+	var primitive string
+	if err := json.Unmarshal(data, &primitive); err == nil {
+		switch primitive {
+		case "Bool":
+			return &TypeTag{Kind: BoolType}, nil
+		case "U8":
+			return &TypeTag{Kind: U8Type}, nil
+		case "U16":
+			return &TypeTag{Kind: U16Type}, nil
+		case "U32":
+			return &TypeTag{Kind: U32Type}, nil
+		case "U64":
+			return &TypeTag{Kind: U64Type}, nil
+		case "U128":
+			return &TypeTag{Kind: U128Type}, nil
+		case "U256":
+			return &TypeTag{Kind: U256Type}, nil
+		case "Address":
+			return &TypeTag{Kind: AddressType}, nil
+		case "Signer":
+			return &TypeTag{Kind: SignerType}, nil
+		default:
+			return nil, fmt.Errorf("unknown type %q", primitive)
+		}
+	}
+
+	var composite struct {
+		Vector        json.RawMessage `json:"Vector"`
+		TypeParameter *uint32         `json:"TypeParameter"`
+		Struct        *struct {
+			Address       string            `json:"address"`
+			Module        string            `json:"module"`
+			Name          string            `json:"name"`
+			TypeArguments []json.RawMessage `json:"typeArguments"`
+		} `json:"Struct"`
+	}
+	if err := json.Unmarshal(data, &composite); err != nil {
+		return nil, err
+	}
+	switch {
+	case composite.Vector != nil:
+		elem, err := ParseNormalizedType(composite.Vector)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeTag{Kind: VectorType, Elem: elem}, nil
+	case composite.TypeParameter != nil:
+		return &TypeTag{Kind: TypeParameterType, Index: *composite.TypeParameter}, nil
+	case composite.Struct != nil:
+		address, err := normalizeAddress(composite.Struct.Address)
+		if err != nil {
+			return nil, err
+		}
+		structTag := &StructTag{Address: address, Module: composite.Struct.Module, Name: composite.Struct.Name}
+		for _, argument := range composite.Struct.TypeArguments {
+			typeParam, err := ParseNormalizedType(argument)
+			if err != nil {
+				return nil, err
+			}
+			structTag.TypeParams = append(structTag.TypeParams, typeParam)
+		}
+		return &TypeTag{Kind: StructType, Struct: structTag}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", string(data))
+	}
+}
+
+// DatatypeFromDescriptor converts the gRPC `MovePackageService.GetDatatype` descriptor.
+func DatatypeFromDescriptor(descriptor *v2.DatatypeDescriptor) (*Datatype, error) {
+	if descriptor == nil {
+		return nil, errors.New("empty datatype descriptor")
+	}
+	datatype := &Datatype{Module: descriptor.GetModule(), Name: descriptor.GetName()}
+	if descriptor.DefiningId != nil {
+		address, err := normalizeAddress(descriptor.GetDefiningId())
+		if err != nil {
+			return nil, err
+		}
+		datatype.Address = address
+	}
+
+	var err error
+	switch descriptor.GetKind() {
+	case v2.DatatypeDescriptor_STRUCT:
+		if datatype.Fields, err = fieldsFromDescriptors(descriptor.GetFields()); err != nil {
+			return nil, err
+		}
+	case v2.DatatypeDescriptor_ENUM:
+		datatype.IsEnum = true
+		for _, variant := range descriptor.GetVariants() {
+			fields, err := fieldsFromDescriptors(variant.GetFields())
+			if err != nil {
+				return nil, err
+			}
+			datatype.Variants = append(datatype.Variants, VariantLayout{Name: variant.GetName(), Fields: fields})
+		}
+	default:
+		return nil, fmt.Errorf("unknown kind of datatype %s", descriptor.GetTypeName())
+	}
+	return datatype, nil
+}
+
+func fieldsFromDescriptors(descriptors []*v2.FieldDescriptor) ([]FieldLayout, error) {
+	fields := make([]FieldLayout, 0, len(descriptors))
+	for _, field := range descriptors {
+		fieldType, err := typeFromSignatureBody(field.GetType())
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of field %s: %v", field.GetName(), err)
+		}
+		fields = append(fields, FieldLayout{Name: field.GetName(), Type: fieldType})
+	}
+	return fields, nil
+}
+
+func typeFromSignatureBody(body *v2.OpenSignatureBody) (*TypeTag, error) {
+	switch body.GetType() {
+	case v2.OpenSignatureBody_ADDRESS:
+		return &TypeTag{Kind: AddressType}, nil
+	case v2.OpenSignatureBody_BOOL:
+		return &TypeTag{Kind: BoolType}, nil
+	case v2.OpenSignatureBody_U8:
+		return &TypeTag{Kind: U8Type}, nil
+	case v2.OpenSignatureBody_U16:
+		return &TypeTag{Kind: U16Type}, nil
+	case v2.OpenSignatureBody_U32:
+		return &TypeTag{Kind: U32Type}, nil
+	case v2.OpenSignatureBody_U64:
+		return &TypeTag{Kind: U64Type}, nil
+	case v2.OpenSignatureBody_U128:
+		return &TypeTag{Kind: U128Type}, nil
+	case v2.OpenSignatureBody_U256:
+		return &TypeTag{Kind: U256Type}, nil
+	case v2.OpenSignatureBody_VECTOR:
+		if len(body.GetTypeParameterInstantiation()) != 1 {
+			return nil, errors.New("vector takes exactly one type argument")
+		}
+		elem, err := typeFromSignatureBody(body.GetTypeParameterInstantiation()[0])
+		if err != nil {
+			return nil, err
+		}
+		return &TypeTag{Kind: VectorType, Elem: elem}, nil
+	case v2.OpenSignatureBody_DATATYPE:
+		structTag, err := ParseStructTag(body.GetTypeName())
+		if err != nil {
+			return nil, err
+		}
+		for _, instantiation := range body.GetTypeParameterInstantiation() {
+			typeParam, err := typeFromSignatureBody(instantiation)
+			if err != nil {
+				return nil, err
+			}
+			structTag.TypeParams = append(structTag.TypeParams, typeParam)
+		}
+		return &TypeTag{Kind: StructType, Struct: structTag}, nil
+	case v2.OpenSignatureBody_TYPE_PARAMETER:
+		return &TypeTag{Kind: TypeParameterType, Index: body.GetTypeParameter()}, nil
+	default:
+		return nil, fmt.Errorf("unknown type %s", body.GetType())
+	}
+}
+
+// substitute replaces the type parameters of the layout type by the type arguments.
+func substitute(typeTag *TypeTag, typeArgs []*TypeTag) (*TypeTag, error) {
+	switch typeTag.Kind {
+	case TypeParameterType:
+		if int(typeTag.Index) >= len(typeArgs) {
+			return nil, fmt.Errorf("missing type argument %d", typeTag.Index)
+		}
+		return typeArgs[typeTag.Index], nil
+	case VectorType:
+		elem, err := substitute(typeTag.Elem, typeArgs)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeTag{Kind: VectorType, Elem: elem}, nil
+	case StructType:
+		structTag := &StructTag{Address: typeTag.Struct.Address, Module: typeTag.Struct.Module, Name: typeTag.Struct.Name}
+		for _, param := range typeTag.Struct.TypeParams {
+			typeParam, err := substitute(param, typeArgs)
+			if err != nil {
+				return nil, err
+			}
+			structTag.TypeParams = append(structTag.TypeParams, typeParam)
+		}
+		return &TypeTag{Kind: StructType, Struct: structTag}, nil
+	default:
+		return typeTag, nil
+	}
+}
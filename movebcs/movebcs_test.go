@@ -0,0 +1,241 @@
+package movebcs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+func TestParseTypeTag(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "u64", want: "u64"},
+		{input: "vector<vector<u8>>", want: "vector<vector<u8>>"},
+		{
+			input: "0x2::coin::Coin<0x2::sui::SUI>",
+			want:  "0x0000000000000000000000000000000000000000000000000000000000000002::coin::Coin<0x0000000000000000000000000000000000000000000000000000000000000002::sui::SUI>",
+		},
+		{
+			input: "0x2::dynamic_field::Field<address, vector<0x1::string::String>>",
+			want:  "0x0000000000000000000000000000000000000000000000000000000000000002::dynamic_field::Field<address, vector<0x0000000000000000000000000000000000000000000000000000000000000001::string::String>>",
+		},
+		{input: "", wantErr: true},
+		{input: "vector<u8", wantErr: true},
+		{input: "vector<u8, u8>", wantErr: true},
+		{input: "0x2::coin", wantErr: true},
+		{input: "0xzz::coin::Coin", wantErr: true},
+		{input: "u64>", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			typeTag, err := ParseTypeTag(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, typeTag.String())
+		})
+	}
+}
+
+// normalizedStructs are `sui_getNormalizedMoveStruct` responses.
+var normalizedStructs = map[string]string{
+	"0x2::object::UID":       `{"abilities":{"abilities":["Store"]},"typeParameters":[],"fields":[{"name":"id","type":{"Struct":{"address":"0x2","module":"object","name":"ID","typeArguments":[]}}}]}`,
+	"0x2::object::ID":        `{"abilities":{"abilities":["Copy","Drop","Store"]},"typeParameters":[],"fields":[{"name":"bytes","type":"Address"}]}`,
+	"0x2::balance::Balance":  `{"abilities":{"abilities":["Store"]},"typeParameters":[{"constraints":{"abilities":[]},"isPhantom":true}],"fields":[{"name":"value","type":"U64"}]}`,
+	"0x2::coin::Coin":        `{"abilities":{"abilities":["Key","Store"]},"typeParameters":[{"constraints":{"abilities":[]},"isPhantom":true}],"fields":[{"name":"id","type":{"Struct":{"address":"0x2","module":"object","name":"UID","typeArguments":[]}}},{"name":"balance","type":{"Struct":{"address":"0x2","module":"balance","name":"Balance","typeArguments":[{"TypeParameter":0}]}}}]}`,
+	"0x1::string::String":    `{"abilities":{"abilities":["Copy","Drop","Store"]},"typeParameters":[],"fields":[{"name":"bytes","type":{"Vector":"U8"}}]}`,
+	"0x1::option::Option":    `{"abilities":{"abilities":["Copy","Drop","Store"]},"typeParameters":[{"constraints":{"abilities":[]},"isPhantom":false}],"fields":[{"name":"vec","type":{"Vector":{"TypeParameter":0}}}]}`,
+	"0xabc::events::Created": `{"abilities":{"abilities":["Copy","Drop"]},"typeParameters":[],"fields":[{"name":"name","type":{"Struct":{"address":"0x1","module":"string","name":"String","typeArguments":[]}}},{"name":"amount","type":"U128"},{"name":"limit","type":{"Struct":{"address":"0x1","module":"option","name":"Option","typeArguments":["U64"]}}},{"name":"data","type":{"Vector":"U8"}},{"name":"active","type":"Bool"}]}`,
+}
+
+type fakeMoveReader struct {
+	calls int
+}
+
+func (f *fakeMoveReader) SuiGetNormalizedMoveStruct(_ context.Context, req models.GetNormalizedMoveStructRequest) (models.GetNormalizedMoveStructResponse, error) {
+	f.calls++
+	address, err := normalizeAddress(req.Package)
+	if err != nil {
+		return models.GetNormalizedMoveStructResponse{}, err
+	}
+	for id, normalized := range normalizedStructs {
+		structTag, err := ParseStructTag(id)
+		if err != nil {
+			return models.GetNormalizedMoveStructResponse{}, err
+		}
+		if structTag.Address == address && structTag.Module == req.ModuleName && structTag.Name == req.StructName {
+			var rsp models.GetNormalizedMoveStructResponse
+			err := json.Unmarshal([]byte(normalized), &rsp)
+			return rsp, err
+		}
+	}
+	return models.GetNormalizedMoveStructResponse{}, fmt.Errorf("struct %s::%s not found", req.ModuleName, req.StructName)
+}
+
+func mustHex(t *testing.T, value string) []byte {
+	data, err := hex.DecodeString(value)
+	require.NoError(t, err)
+	return data
+}
+
+func TestDecodeObject(t *testing.T) {
+	reader := &fakeMoveReader{}
+	decoder := NewDecoder(NewJsonRpcResolver(reader))
+
+	id := "5c9c7d0a3e7a5b2f3a7c1a0d2b4f6e8a9c0b1d2e3f405162738495a6b7c8d9e0"
+	contents := append(mustHex(t, id), 0x00, 0xe1, 0xf5, 0x05, 0x00, 0x00, 0x00, 0x00)
+	object := &models.SuiObjectData{Bcs: &models.SuiRawData{
+		DataType: "moveObject",
+		SuiRawMoveObject: models.SuiRawMoveObject{
+			Type:     "0x2::coin::Coin<0x2::sui::SUI>",
+			BcsBytes: base64.StdEncoding.EncodeToString(contents),
+		},
+	}}
+
+	coin, err := decoder.DecodeObject(context.Background(), object)
+	require.NoError(t, err)
+	require.True(t, coin.Type.Is("0x2", "coin", "Coin"))
+	require.Equal(t, U64(100000000), coin.Field("balance").(*Struct).Field("value"))
+
+	data, err := MarshalJSON(coin)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance":"100000000","id":{"id":"0x`+id+`"}}`, string(data))
+
+	// the layouts are cached
+	calls := reader.calls
+	_, err = decoder.DecodeObject(context.Background(), object)
+	require.NoError(t, err)
+	require.Equal(t, calls, reader.calls)
+
+	_, err = decoder.DecodeStruct(context.Background(), "0x2::coin::Coin<0x2::sui::SUI>", append(contents, 0x00))
+	require.ErrorIs(t, err, ErrTrailingBytes)
+	_, err = decoder.DecodeStruct(context.Background(), "0x2::coin::Coin<0x2::sui::SUI>", contents[:35])
+	require.Error(t, err)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	decoder := NewDecoder(NewJsonRpcResolver(&fakeMoveReader{}))
+
+	var contents []byte
+	contents = append(contents, 0x03, 'S', 'u', 'i')                                  // name
+	contents = append(contents, mustHex(t, "ffffffffffffffffffffffffffffffff")...)    // amount
+	contents = append(contents, 0x01, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00) // limit
+	contents = append(contents, 0x02, 0x01, 0x02)                                     // data
+	contents = append(contents, 0x01)                                                 // active
+
+	for _, event := range []*models.SuiEventResponse{
+		{Type: "0xabc::events::Created", Bcs: base58.Encode(contents)},
+		{Type: "0xabc::events::Created", Bcs: base64.StdEncoding.EncodeToString(contents), BcsEncoding: "base64"},
+	} {
+		value, err := decoder.DecodeEvent(context.Background(), event)
+		require.NoError(t, err)
+		data, err := MarshalJSON(value)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"name":"Sui","amount":"340282366920938463463374607431768211455","limit":"7","data":[1,2],"active":true}`, string(data))
+	}
+
+	// a vector longer than the remaining bytes is rejected before allocating it
+	_, err := decoder.DecodeStruct(context.Background(), "0xabc::events::Created", []byte{0xff, 0xff, 0xff, 0xff, 0x0f})
+	require.Error(t, err)
+}
+
+func TestReadUleb128(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    uint32
+		wantErr string
+	}{
+		{name: "zero", data: []byte{0x00}, want: 0},
+		{name: "one byte", data: []byte{0x7f}, want: 127},
+		{name: "two bytes", data: []byte{0x80, 0x01}, want: 128},
+		{name: "max uint32", data: []byte{0xff, 0xff, 0xff, 0xff, 0x0f}, want: math.MaxUint32},
+		{name: "trailing zero byte", data: []byte{0x80, 0x00}, wantErr: "non-canonical"},
+		{name: "padded value", data: []byte{0x81, 0x80, 0x00}, wantErr: "non-canonical"},
+		{name: "over 32 bits", data: []byte{0xff, 0xff, 0xff, 0xff, 0x10}, wantErr: "invalid uleb128"},
+		{name: "truncated", data: []byte{0x80}, wantErr: "invalid uleb128"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reader{data: tt.data}
+			value, err := r.readUleb128()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, value)
+			require.Equal(t, len(tt.data), r.pos)
+		})
+	}
+
+	// 2^31 is over the BCS limit of vector lengths
+	r := &reader{data: []byte{0x80, 0x80, 0x80, 0x80, 0x08}}
+	_, err := r.readLength()
+	require.ErrorIs(t, err, mystenbcs.ErrMaxSequenceLength)
+}
+
+func TestDecodeEnum(t *testing.T) {
+	resolver := NewCachedResolver(NewJsonRpcResolver(&fakeMoveReader{}))
+	status, err := DatatypeFromDescriptor(&v2.DatatypeDescriptor{
+		DefiningId: stringPtr("0xabc"),
+		Module:     stringPtr("market"),
+		Name:       stringPtr("Status"),
+		Kind:       v2.DatatypeDescriptor_ENUM.Enum(),
+		Variants: []*v2.VariantDescriptor{
+			{Name: stringPtr("Open")},
+			{Name: stringPtr("Closed"), Fields: []*v2.FieldDescriptor{
+				{Name: stringPtr("at"), Type: &v2.OpenSignatureBody{Type: v2.OpenSignatureBody_U64.Enum()}},
+				{Name: stringPtr("reason"), Type: &v2.OpenSignatureBody{
+					Type:                       v2.OpenSignatureBody_DATATYPE.Enum(),
+					TypeName:                   stringPtr("0x1::option::Option"),
+					TypeParameterInstantiation: []*v2.OpenSignatureBody{{Type: v2.OpenSignatureBody_TYPE_PARAMETER.Enum(), TypeParameter: uint32Ptr(0)}},
+				}},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	resolver.Add(status)
+	decoder := NewDecoder(resolver)
+	typeTag, err := ParseTypeTag("0xabc::market::Status<0x1::string::String>")
+	require.NoError(t, err)
+
+	value, err := decoder.Decode(context.Background(), typeTag, []byte{0x00})
+	require.NoError(t, err)
+	require.Equal(t, "Open", value.(*Variant).Name)
+
+	value, err = decoder.Decode(context.Background(), typeTag, []byte{0x01, 0x2a, 0, 0, 0, 0, 0, 0, 0, 0x01, 0x02, 'o', 'k'})
+	require.NoError(t, err)
+	variant := value.(*Variant)
+	require.Equal(t, 1, variant.Index)
+	data, err := MarshalJSON(variant)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"`+typeTag.String()+`","variant":"Closed","fields":{"at":"42","reason":"ok"}}`, string(data))
+
+	_, err = decoder.Decode(context.Background(), typeTag, []byte{0x02})
+	require.Error(t, err)
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func uint32Ptr(value uint32) *uint32 {
+	return &value
+}
@@ -0,0 +1,108 @@
+package movebcs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/models/sui_json_rpc_types"
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+// LayoutResolver returns the layout of a datatype, the address is normalized.
+type LayoutResolver interface {
+	ResolveDatatype(ctx context.Context, address, module, name string) (*Datatype, error)
+}
+
+// NormalizedStructReader is the part of the Sui client used by JsonRpcResolver, sui.ISuiAPI implements it.
+type NormalizedStructReader interface {
+	SuiGetNormalizedMoveStruct(ctx context.Context, req models.GetNormalizedMoveStructRequest) (models.GetNormalizedMoveStructResponse, error)
+}
+
+// JsonRpcResolver resolves layouts with `sui_getNormalizedMoveStruct`. Enums are not returned by
+// the method, use GrpcResolver to decode values containing enums.
+type JsonRpcResolver struct {
+	client NormalizedStructReader
+}
+
+func NewJsonRpcResolver(client NormalizedStructReader) *JsonRpcResolver {
+	return &JsonRpcResolver{client: client}
+}
+
+func (r *JsonRpcResolver) ResolveDatatype(ctx context.Context, address, module, name string) (*Datatype, error) {
+	rsp, err := r.client.SuiGetNormalizedMoveStruct(ctx, models.GetNormalizedMoveStructRequest{
+		Package:    address,
+		ModuleName: module,
+		StructName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DatatypeFromNormalizedStruct(address, module, name, sui_json_rpc_types.SuiMoveNormalizedStruct(rsp))
+}
+
+// GrpcResolver resolves layouts with `MovePackageService.GetDatatype`.
+type GrpcResolver struct {
+	service v2.MovePackageServiceClient
+}
+
+func NewGrpcResolver(service v2.MovePackageServiceClient) *GrpcResolver {
+	return &GrpcResolver{service: service}
+}
+
+func (r *GrpcResolver) ResolveDatatype(ctx context.Context, address, module, name string) (*Datatype, error) {
+	rsp, err := r.service.GetDatatype(ctx, &v2.GetDatatypeRequest{
+		PackageId:  &address,
+		ModuleName: &module,
+		Name:       &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	datatype, err := DatatypeFromDescriptor(rsp.GetDatatype())
+	if err != nil {
+		return nil, err
+	}
+	if datatype.Address == "" {
+		datatype.Address = address
+	}
+	return datatype, nil
+}
+
+// CachedResolver caches the layouts of another resolver, layouts never change for a package
+// address so the cache is never invalidated. It is safe for concurrent use.
+type CachedResolver struct {
+	resolver LayoutResolver
+	lock     sync.RWMutex
+	cache    map[string]*Datatype
+}
+
+func NewCachedResolver(resolver LayoutResolver) *CachedResolver {
+	return &CachedResolver{resolver: resolver, cache: make(map[string]*Datatype)}
+}
+
+func (r *CachedResolver) ResolveDatatype(ctx context.Context, address, module, name string) (*Datatype, error) {
+	key := address + "::" + module + "::" + name
+	r.lock.RLock()
+	datatype, ok := r.cache[key]
+	r.lock.RUnlock()
+	if ok {
+		return datatype, nil
+	}
+
+	datatype, err := r.resolver.ResolveDatatype(ctx, address, module, name)
+	if err != nil {
+		return nil, err
+	}
+	r.lock.Lock()
+	r.cache[key] = datatype
+	r.lock.Unlock()
+	return datatype, nil
+}
+
+// Add stores a layout in the cache, e.g. one known in advance.
+func (r *CachedResolver) Add(datatype *Datatype) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cache[datatype.Address+"::"+datatype.Module+"::"+datatype.Name] = datatype
+}
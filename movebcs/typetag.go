@@ -0,0 +1,249 @@
+package movebcs
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+type TypeKind int
+
+const (
+	BoolType TypeKind = iota
+	U8Type
+	U16Type
+	U32Type
+	U64Type
+	U128Type
+	U256Type
+	AddressType
+	SignerType
+	VectorType
+	StructType
+	// TypeParameterType only appears in layouts, it is substituted by the type arguments when decoding.
+	TypeParameterType
+)
+
+var primitiveTypes = map[string]TypeKind{
+	"bool":    BoolType,
+	"u8":      U8Type,
+	"u16":     U16Type,
+	"u32":     U32Type,
+	"u64":     U64Type,
+	"u128":    U128Type,
+	"u256":    U256Type,
+	"address": AddressType,
+	"signer":  SignerType,
+}
+
+// TypeTag is a Move type, e.g. `u64`, `vector<u8>` or `0x2::coin::Coin<0x2::sui::SUI>`.
+type TypeTag struct {
+	Kind TypeKind
+	// Elem is the element type of a vector.
+	Elem *TypeTag
+	// Struct is the datatype of a struct or enum.
+	Struct *StructTag
+	// Index is the index of a type parameter.
+	Index uint32
+}
+
+// StructTag is a Move datatype, a struct or an enum, with its type arguments.
+type StructTag struct {
+	// Address is the normalized 0x prefixed 64 hex address of the package.
+	Address    string
+	Module     string
+	Name       string
+	TypeParams []*TypeTag
+}
+
+// ParseTypeTag parses a Move type, addresses may be short as in `0x2::sui::SUI`.
+func ParseTypeTag(value string) (*TypeTag, error) {
+	p := &typeParser{input: value}
+	typeTag, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("invalid type %q: unexpected %q at %d", value, p.input[p.pos:], p.pos)
+	}
+	return typeTag, nil
+}
+
+// ParseStructTag parses a Move datatype, e.g. the type of an object or an event.
+func ParseStructTag(value string) (*StructTag, error) {
+	typeTag, err := ParseTypeTag(value)
+	if err != nil {
+		return nil, err
+	}
+	if typeTag.Kind != StructType {
+		return nil, fmt.Errorf("invalid struct type %q", value)
+	}
+	return typeTag.Struct, nil
+}
+
+func (t *TypeTag) String() string {
+	switch t.Kind {
+	case VectorType:
+		return "vector<" + t.Elem.String() + ">"
+	case StructType:
+		return t.Struct.String()
+	case TypeParameterType:
+		return fmt.Sprintf("T%d", t.Index)
+	}
+	for name, kind := range primitiveTypes {
+		if kind == t.Kind {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// String returns the datatype with its normalized addresses.
+func (s *StructTag) String() string {
+	name := s.Address + "::" + s.Module + "::" + s.Name
+	if len(s.TypeParams) == 0 {
+		return name
+	}
+	params := make([]string, len(s.TypeParams))
+	for i, param := range s.TypeParams {
+		params[i] = param.String()
+	}
+	return name + "<" + strings.Join(params, ", ") + ">"
+}
+
+// ID returns `address::module::name` without the type arguments, which identifies the layout.
+func (s *StructTag) ID() string {
+	return s.Address + "::" + s.Module + "::" + s.Name
+}
+
+// Is reports whether the datatype is address::module::name, the address may be short.
+func (s *StructTag) Is(address, module, name string) bool {
+	normalized, err := normalizeAddress(address)
+	return err == nil && s.Address == normalized && s.Module == module && s.Name == name
+}
+
+type typeParser struct {
+	input string
+	pos   int
+}
+
+func (p *typeParser) parseType() (*TypeTag, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && isIdentifierByte(p.input[p.pos]) {
+		p.pos++
+	}
+	token := p.input[start:p.pos]
+	if token == "" {
+		return nil, p.errorf("expected a type")
+	}
+
+	if kind, ok := primitiveTypes[token]; ok {
+		return &TypeTag{Kind: kind}, nil
+	}
+	if token == "vector" {
+		params, err := p.parseTypeParams()
+		if err != nil {
+			return nil, err
+		}
+		if len(params) != 1 {
+			return nil, p.errorf("vector takes exactly one type argument")
+		}
+		return &TypeTag{Kind: VectorType, Elem: params[0]}, nil
+	}
+
+	address, err := normalizeAddress(token)
+	if err != nil {
+		return nil, p.errorf("%v", err)
+	}
+	module, err := p.parsePathIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.parsePathIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	structTag := &StructTag{Address: address, Module: module, Name: name}
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '<' {
+		if structTag.TypeParams, err = p.parseTypeParams(); err != nil {
+			return nil, err
+		}
+	}
+	return &TypeTag{Kind: StructType, Struct: structTag}, nil
+}
+
+func (p *typeParser) parsePathIdentifier() (string, error) {
+	p.skipSpaces()
+	if !strings.HasPrefix(p.input[p.pos:], "::") {
+		return "", p.errorf("expected ::")
+	}
+	p.pos += 2
+	start := p.pos
+	for p.pos < len(p.input) && isIdentifierByte(p.input[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected an identifier")
+	}
+	return p.input[start:p.pos], nil
+}
+
+func (p *typeParser) parseTypeParams() ([]*TypeTag, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || p.input[p.pos] != '<' {
+		return nil, p.errorf("expected <")
+	}
+	p.pos++
+
+	var params []*TypeTag
+	for {
+		param, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("expected > or ,")
+		}
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case '>':
+			p.pos++
+			return params, nil
+		default:
+			return nil, p.errorf("expected > or ,")
+		}
+	}
+}
+
+func (p *typeParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid type %q at %d: %s", p.input, p.pos, fmt.Sprintf(format, args...))
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func normalizeAddress(address string) (string, error) {
+	value := strings.TrimPrefix(strings.ToLower(address), "0x")
+	if value == "" || len(value) > 64 {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+	value = strings.Repeat("0", 64-len(value)) + value
+	if _, err := hex.DecodeString(value); err != nil {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+	return "0x" + value, nil
+}
@@ -0,0 +1,86 @@
+package movebcs
+
+import (
+	"encoding/hex"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// MoveValue is a decoded Move value, one of Bool, U8, U16, U32, U64, U128, U256, Address,
+// Vector, *Struct or *Variant.
+type MoveValue interface {
+	isMoveValue()
+}
+
+type Bool bool
+type U8 uint8
+type U16 uint16
+type U32 uint32
+type U64 uint64
+
+type U128 struct {
+	mystenbcs.U128
+}
+
+type U256 struct {
+	mystenbcs.U256
+}
+
+type Address [32]byte
+
+// String returns the 0x prefixed 64 hex address.
+func (a Address) String() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+type Vector []MoveValue
+
+type Field struct {
+	Name  string
+	Value MoveValue
+}
+
+type Struct struct {
+	Type   *StructTag
+	Fields []Field
+}
+
+// Field returns the value of the field, nil when the struct has no such field.
+func (s *Struct) Field(name string) MoveValue {
+	return fieldValue(s.Fields, name)
+}
+
+// Variant is a value of a Move enum.
+type Variant struct {
+	Type *StructTag
+	// Name is the name of the variant, Index its position in the enum.
+	Name   string
+	Index  int
+	Fields []Field
+}
+
+// Field returns the value of the field, nil when the variant has no such field.
+func (v *Variant) Field(name string) MoveValue {
+	return fieldValue(v.Fields, name)
+}
+
+func fieldValue(fields []Field, name string) MoveValue {
+	for _, field := range fields {
+		if field.Name == name {
+			return field.Value
+		}
+	}
+	return nil
+}
+
+func (Bool) isMoveValue()     {}
+func (U8) isMoveValue()       {}
+func (U16) isMoveValue()      {}
+func (U32) isMoveValue()      {}
+func (U64) isMoveValue()      {}
+func (U128) isMoveValue()     {}
+func (U256) isMoveValue()     {}
+func (Address) isMoveValue()  {}
+func (Vector) isMoveValue()   {}
+func (*Struct) isMoveValue()  {}
+func (*Variant) isMoveValue() {}
@@ -0,0 +1,58 @@
+package multisig
+
+//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+
+// PublicKey https://github.com/MystenLabs/sui/blob/main/crates/sui-types/src/crypto.rs
+// - ED25519
+// - Secp256k1
+// - Secp256r1
+// - ZkLogin
+// - Passkey
+//
+//bcs:generate
+type PublicKey struct {
+	ED25519   *[32]byte
+	Secp256k1 *[33]byte
+	Secp256r1 *[33]byte
+	ZkLogin   *[]byte
+	Passkey   *[33]byte
+}
+
+func (PublicKey) IsBcsEnum() {}
+
+// CompressedSignature https://github.com/MystenLabs/sui/blob/main/crates/sui-types/src/multisig.rs
+// - ED25519
+// - Secp256k1
+// - Secp256r1
+// - ZkLogin, the serialized zkLogin signature
+// - Passkey, the serialized passkey signature
+//
+//bcs:generate
+type CompressedSignature struct {
+	ED25519   *[64]byte
+	Secp256k1 *[64]byte
+	Secp256r1 *[64]byte
+	ZkLogin   *[]byte
+	Passkey   *[]byte
+}
+
+func (CompressedSignature) IsBcsEnum() {}
+
+//bcs:generate
+type MultiSigPkMap struct {
+	PubKey PublicKey
+	Weight uint8
+}
+
+//bcs:generate
+type MultiSigPublicKeyStruct struct {
+	PkMap     []MultiSigPkMap
+	Threshold uint16
+}
+
+//bcs:generate
+type MultiSigStruct struct {
+	Sigs       []CompressedSignature
+	Bitmap     uint16
+	MultisigPk MultiSigPublicKeyStruct
+}
@@ -0,0 +1,274 @@
+// Code generated by bcsgen. DO NOT EDIT.
+
+package multisig
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func (v PublicKey) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *PublicKey) AppendBCS(b []byte) ([]byte, error) {
+	switch {
+	case v.ED25519 != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		b = append(b, (*v.ED25519)[:]...)
+	case v.Secp256k1 != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		b = append(b, (*v.Secp256k1)[:]...)
+	case v.Secp256r1 != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		b = append(b, (*v.Secp256r1)[:]...)
+	case v.ZkLogin != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		b = mystenbcs.AppendULEB128(b, len(*v.ZkLogin))
+		b = append(b, *v.ZkLogin...)
+	case v.Passkey != nil:
+		b = mystenbcs.AppendULEB128(b, 4)
+		b = append(b, (*v.Passkey)[:]...)
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *PublicKey) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *PublicKey) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.ED25519 == nil {
+			v.ED25519 = new([32]byte)
+		}
+		r.ReadFull((*v.ED25519)[:])
+	case 1:
+		if v.Secp256k1 == nil {
+			v.Secp256k1 = new([33]byte)
+		}
+		r.ReadFull((*v.Secp256k1)[:])
+	case 2:
+		if v.Secp256r1 == nil {
+			v.Secp256r1 = new([33]byte)
+		}
+		r.ReadFull((*v.Secp256r1)[:])
+	case 3:
+		if v.ZkLogin == nil {
+			v.ZkLogin = new([]byte)
+		}
+		if data2 := r.ReadBytes(); data2 != nil {
+			*v.ZkLogin = data2
+		}
+	case 4:
+		if v.Passkey == nil {
+			v.Passkey = new([33]byte)
+		}
+		r.ReadFull((*v.Passkey)[:])
+	default:
+		r.FailUnknownVariant(index1, "multisig.PublicKey")
+	}
+	r.Leave()
+}
+
+func (v CompressedSignature) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *CompressedSignature) AppendBCS(b []byte) ([]byte, error) {
+	switch {
+	case v.ED25519 != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		b = append(b, (*v.ED25519)[:]...)
+	case v.Secp256k1 != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		b = append(b, (*v.Secp256k1)[:]...)
+	case v.Secp256r1 != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		b = append(b, (*v.Secp256r1)[:]...)
+	case v.ZkLogin != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		b = mystenbcs.AppendULEB128(b, len(*v.ZkLogin))
+		b = append(b, *v.ZkLogin...)
+	case v.Passkey != nil:
+		b = mystenbcs.AppendULEB128(b, 4)
+		b = mystenbcs.AppendULEB128(b, len(*v.Passkey))
+		b = append(b, *v.Passkey...)
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *CompressedSignature) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *CompressedSignature) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.ED25519 == nil {
+			v.ED25519 = new([64]byte)
+		}
+		r.ReadFull((*v.ED25519)[:])
+	case 1:
+		if v.Secp256k1 == nil {
+			v.Secp256k1 = new([64]byte)
+		}
+		r.ReadFull((*v.Secp256k1)[:])
+	case 2:
+		if v.Secp256r1 == nil {
+			v.Secp256r1 = new([64]byte)
+		}
+		r.ReadFull((*v.Secp256r1)[:])
+	case 3:
+		if v.ZkLogin == nil {
+			v.ZkLogin = new([]byte)
+		}
+		if data2 := r.ReadBytes(); data2 != nil {
+			*v.ZkLogin = data2
+		}
+	case 4:
+		if v.Passkey == nil {
+			v.Passkey = new([]byte)
+		}
+		if data3 := r.ReadBytes(); data3 != nil {
+			*v.Passkey = data3
+		}
+	default:
+		r.FailUnknownVariant(index1, "multisig.CompressedSignature")
+	}
+	r.Leave()
+}
+
+func (v MultiSigPkMap) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *MultiSigPkMap) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.PubKey.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = append(b, byte(v.Weight))
+	return b, nil
+}
+
+func (v *MultiSigPkMap) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *MultiSigPkMap) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.PubKey.DecodeBCS(r)
+	v.Weight = r.ReadUint8()
+	r.Leave()
+}
+
+func (v MultiSigPublicKeyStruct) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *MultiSigPublicKeyStruct) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.PkMap))
+	for i1 := range v.PkMap {
+		if b, err = v.PkMap[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	b = binary.LittleEndian.AppendUint16(b, uint16(v.Threshold))
+	return b, nil
+}
+
+func (v *MultiSigPublicKeyStruct) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *MultiSigPublicKeyStruct) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]MultiSigPkMap, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 MultiSigPkMap
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.PkMap = slice2
+	v.Threshold = r.ReadUint16()
+	r.Leave()
+}
+
+func (v MultiSigStruct) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *MultiSigStruct) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.Sigs))
+	for i1 := range v.Sigs {
+		if b, err = v.Sigs[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	b = binary.LittleEndian.AppendUint16(b, uint16(v.Bitmap))
+	if b, err = v.MultisigPk.AppendBCS(b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *MultiSigStruct) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *MultiSigStruct) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]CompressedSignature, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 CompressedSignature
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Sigs = slice2
+	v.Bitmap = r.ReadUint16()
+	v.MultisigPk.DecodeBCS(r)
+	r.Leave()
+}
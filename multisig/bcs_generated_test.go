@@ -0,0 +1,26 @@
+package multisig
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcstest"
+)
+
+func TestGeneratedBcs(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(testing.TB, *rand.Rand, int)
+	}{
+		{name: "PublicKey", check: bcstest.Check[PublicKey]},
+		{name: "CompressedSignature", check: bcstest.Check[CompressedSignature]},
+		{name: "MultiSigPkMap", check: bcstest.Check[MultiSigPkMap]},
+		{name: "MultiSigPublicKeyStruct", check: bcstest.Check[MultiSigPublicKeyStruct]},
+		{name: "MultiSigStruct", check: bcstest.Check[MultiSigStruct]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, rand.New(rand.NewSource(1)), 500)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package multisig
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+func TestCombinePartialSignatures(t *testing.T) {
+	edSigner := signer.NewSigner(make([]byte, 32))
+	k1Signer, err := signer.GenerateSecp256k1Signer()
+	require.NoError(t, err)
+	r1Signer, err := signer.GenerateSecp256r1Signer()
+	require.NoError(t, err)
+
+	// 2-of-3
+	publicKey, err := NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.ED25519, PubKey: edSigner.PubKey, Weight: 1},
+		{SignatureScheme: scheme.Secp256k1, PubKey: k1Signer.PubKey, Weight: 1},
+		{SignatureScheme: scheme.Secp256r1, PubKey: r1Signer.PubKey, Weight: 1},
+	}, 2, nil)
+	require.NoError(t, err)
+
+	parsed, err := NewMultiSigPublicKey(publicKey.ToRawBytes(), nil)
+	require.NoError(t, err)
+	require.Equal(t, publicKey.ToSuiAddress(), parsed.ToSuiAddress())
+	require.Equal(t, uint16(2), parsed.GetThreshold())
+
+	txBytes := []byte("transaction data")
+	b64TxBytes := base64.StdEncoding.EncodeToString(txBytes)
+	edSig, err := edSigner.SignMessage(b64TxBytes, constant.TransactionDataIntentScope)
+	require.NoError(t, err)
+	k1Sig, err := k1Signer.SignTransaction(b64TxBytes)
+	require.NoError(t, err)
+	r1Sig, err := r1Signer.SignTransaction(b64TxBytes)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		signatures []string
+		wantPass   bool
+		wantErr    bool
+	}{
+		{name: "ed25519 and secp256k1", signatures: []string{edSig.Signature, k1Sig.Signature}, wantPass: true},
+		{name: "out of order", signatures: []string{r1Sig.Signature, edSig.Signature}, wantPass: true},
+		{name: "all members", signatures: []string{k1Sig.Signature, r1Sig.Signature, edSig.Signature}, wantPass: true},
+		{name: "below threshold", signatures: []string{r1Sig.Signature}, wantPass: false},
+		{name: "duplicated", signatures: []string{r1Sig.Signature, r1Sig.Signature}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multisig, err := publicKey.CombinePartialSignatures(tt.signatures)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			signature, err := base64.StdEncoding.DecodeString(multisig)
+			require.NoError(t, err)
+			pass, err := publicKey.VerifyTransaction(txBytes, signature, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPass, pass)
+
+			pass, err = publicKey.VerifyPersonalMessage(txBytes, signature, nil)
+			require.NoError(t, err)
+			require.False(t, pass)
+
+			_, err = ParseSerializedMultiSigSignature(append(signature, 0))
+			require.ErrorContains(t, err, mystenbcs.ErrTrailingBytes.Error())
+		})
+	}
+
+	other := signer.NewSigner(append(make([]byte, 31), 1))
+	otherSig, err := other.SignMessage(b64TxBytes, constant.TransactionDataIntentScope)
+	require.NoError(t, err)
+	_, err = publicKey.CombinePartialSignatures([]string{otherSig.Signature})
+	require.Error(t, err)
+}
+
+func TestNewMultiSigPublicKeyFromPublicKeys(t *testing.T) {
+	pubKey := make([]byte, 32)
+
+	_, err := NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.ED25519, PubKey: pubKey, Weight: 1},
+	}, 2, nil)
+	require.Error(t, err, "unreachable threshold")
+
+	_, err = NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.ED25519, PubKey: pubKey, Weight: 1},
+		{SignatureScheme: scheme.ED25519, PubKey: pubKey, Weight: 1},
+	}, 1, nil)
+	require.Error(t, err, "duplicated key")
+
+	_, err = NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.Secp256k1, PubKey: pubKey, Weight: 1},
+	}, 1, nil)
+	require.Error(t, err, "invalid key length")
+}
+
+func TestMultiSigSigner(t *testing.T) {
+	edSigner := signer.NewSigner(make([]byte, 32))
+	k1Signer, err := signer.GenerateSecp256k1Signer()
+	require.NoError(t, err)
+	r1Signer, err := signer.GenerateSecp256r1Signer()
+	require.NoError(t, err)
+
+	publicKey, err := NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.ED25519, PubKey: edSigner.PubKey, Weight: 1},
+		{SignatureScheme: scheme.Secp256k1, PubKey: k1Signer.PubKey, Weight: 1},
+		{SignatureScheme: scheme.Secp256r1, PubKey: r1Signer.PubKey, Weight: 1},
+	}, 2, nil)
+	require.NoError(t, err)
+
+	_, err = NewMultiSigSigner(publicKey, []models.Signer{r1Signer})
+	require.Error(t, err)
+	_, err = NewMultiSigSigner(publicKey, []models.Signer{r1Signer, signer.NewSigner(append(make([]byte, 31), 1))})
+	require.Error(t, err)
+
+	multiSigSigner, err := NewMultiSigSigner(publicKey, []models.Signer{r1Signer, edSigner})
+	require.NoError(t, err)
+	require.Equal(t, publicKey.ToSuiAddress(), multiSigSigner.ToSuiAddress())
+
+	txBytes := []byte("transaction data")
+	multisig, err := models.SignWithIntent(context.Background(), multiSigSigner, txBytes, constant.TransactionDataIntentScope)
+	require.NoError(t, err)
+
+	signature, err := base64.StdEncoding.DecodeString(multisig)
+	require.NoError(t, err)
+	pass, err := publicKey.VerifyTransaction(txBytes, signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+}
+
+// FuzzVerifyTransaction parses and verifies untrusted multisig signatures, and the public keys
+// they carry.
+func FuzzVerifyTransaction(f *testing.F) {
+	edSigner := signer.NewSigner(make([]byte, 32))
+	otherSigner := signer.NewSigner(append(make([]byte, 31), 1))
+	publicKey, err := NewMultiSigPublicKeyFromPublicKeys([]PubkeyWeightPair{
+		{SignatureScheme: scheme.ED25519, PubKey: edSigner.PubKey, Weight: 1},
+		{SignatureScheme: scheme.ED25519, PubKey: otherSigner.PubKey, Weight: 1},
+	}, 2, nil)
+	require.NoError(f, err)
+	multiSigSigner, err := NewMultiSigSigner(publicKey, []models.Signer{edSigner, otherSigner})
+	require.NoError(f, err)
+
+	txBytes := []byte("transaction data")
+	multisig, err := models.SignWithIntent(context.Background(), multiSigSigner, txBytes, constant.TransactionDataIntentScope)
+	require.NoError(f, err)
+	signature, err := base64.StdEncoding.DecodeString(multisig)
+	require.NoError(f, err)
+	f.Add(signature)
+	f.Add(signature[:len(signature)-1])
+
+	f.Fuzz(func(t *testing.T, signature []byte) {
+		_, _ = publicKey.VerifyTransaction(txBytes, signature, nil)
+
+		parsed, err := ParseSerializedMultiSigSignature(signature)
+		if err != nil {
+			return
+		}
+		if parsedKey, err := NewMultiSigPublicKey(parsed.PubKey, nil); err == nil {
+			_, _ = parsedKey.VerifyTransaction(txBytes, signature, nil)
+		}
+	})
+}
@@ -0,0 +1,327 @@
+package multisig
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/machinebox/graphql"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/ed25519"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256k1"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/passkey"
+	"github.com/block-vision/sui-go-sdk/zklogin"
+)
+
+const (
+	// MaxSignerInMultisig is the max number of public keys in a multisig public key.
+	MaxSignerInMultisig = 10
+	// MinSignerInMultisig is the min number of public keys in a multisig public key.
+	MinSignerInMultisig = 1
+)
+
+// PubkeyWeightPair is a member of the multisig public key.
+type PubkeyWeightPair struct {
+	SignatureScheme scheme.SignatureScheme
+	PubKey          []byte
+	Weight          uint8
+}
+
+type MultiSigPublicKey struct {
+	data              []byte
+	multisigPublicKey MultiSigPublicKeyStruct
+	publicKeys        []PubkeyWeightPair
+	options           *zklogin.ZkLoginPublicIdentifierOptions
+}
+
+// NewMultiSigPublicKey parses the bcs encoded multisig public key. The options are used to
+// verify the signatures of zkLogin members.
+func NewMultiSigPublicKey(data []byte, options *zklogin.ZkLoginPublicIdentifierOptions) (*MultiSigPublicKey, error) {
+	var multisigPublicKey MultiSigPublicKeyStruct
+	if _, err := mystenbcs.Unmarshal(data, &multisigPublicKey); err != nil {
+		return nil, fmt.Errorf("failed to parse multisig public key: %v", err)
+	}
+
+	return newMultiSigPublicKey(data, multisigPublicKey, options)
+}
+
+func newMultiSigPublicKey(data []byte, multisigPublicKey MultiSigPublicKeyStruct, options *zklogin.ZkLoginPublicIdentifierOptions) (*MultiSigPublicKey, error) {
+	if multisigPublicKey.Threshold == 0 {
+		return nil, errors.New("invalid multisig threshold")
+	}
+	if len(multisigPublicKey.PkMap) < MinSignerInMultisig || len(multisigPublicKey.PkMap) > MaxSignerInMultisig {
+		return nil, fmt.Errorf("invalid number of public keys: %d", len(multisigPublicKey.PkMap))
+	}
+
+	var totalWeight uint16
+	publicKeys := make([]PubkeyWeightPair, 0, len(multisigPublicKey.PkMap))
+	for _, pkMap := range multisigPublicKey.PkMap {
+		signatureScheme, pubKey, err := pkMap.PubKey.schemeAndBytes()
+		if err != nil {
+			return nil, err
+		}
+		if pkMap.Weight == 0 {
+			return nil, errors.New("invalid multisig weight")
+		}
+		for _, existing := range publicKeys {
+			if existing.SignatureScheme == signatureScheme && bytes.Equal(existing.PubKey, pubKey) {
+				return nil, errors.New("duplicated public key in multisig")
+			}
+		}
+		totalWeight += uint16(pkMap.Weight)
+		publicKeys = append(publicKeys, PubkeyWeightPair{
+			SignatureScheme: signatureScheme,
+			PubKey:          pubKey,
+			Weight:          pkMap.Weight,
+		})
+	}
+	if totalWeight < multisigPublicKey.Threshold {
+		return nil, errors.New("unreachable multisig threshold")
+	}
+
+	return &MultiSigPublicKey{
+		data:              data,
+		multisigPublicKey: multisigPublicKey,
+		publicKeys:        publicKeys,
+		options:           options,
+	}, nil
+}
+
+// ToRawBytes returns the bcs encoded multisig public key.
+func (p *MultiSigPublicKey) ToRawBytes() []byte {
+	return p.data
+}
+
+func (p *MultiSigPublicKey) Scheme() scheme.SignatureScheme {
+	return scheme.MultiSig
+}
+
+func (p *MultiSigPublicKey) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.MultiSig]
+}
+
+func (p *MultiSigPublicKey) ToSuiBytes() []byte {
+	return models.ToSuiBytes(p.Flag(), p.data)
+}
+
+func (p *MultiSigPublicKey) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(p.ToSuiBytes())
+}
+
+func (p *MultiSigPublicKey) GetPublicKeys() []PubkeyWeightPair {
+	return p.publicKeys
+}
+
+func (p *MultiSigPublicKey) GetThreshold() uint16 {
+	return p.multisigPublicKey.Threshold
+}
+
+// ToSuiAddress returns blake2b(0x03 || threshold || flag_1 || pk_1 || weight_1 || ... || flag_n || pk_n || weight_n).
+func (p *MultiSigPublicKey) ToSuiAddress() string {
+	buf := []byte{scheme.SignatureSchemeToFlag[scheme.MultiSig]}
+	buf = binary.LittleEndian.AppendUint16(buf, p.multisigPublicKey.Threshold)
+	for _, pk := range p.publicKeys {
+		buf = append(buf, scheme.SignatureSchemeToFlag[pk.SignatureScheme])
+		buf = append(buf, pk.PubKey...)
+		buf = append(buf, pk.Weight)
+	}
+
+	addrBytes := blake2b.Sum256(buf)
+	return fmt.Sprintf("0x%s", hex.EncodeToString(addrBytes[:]))
+}
+
+// VerifyPersonalMessage verifies the serialized multisig signature over the personal message.
+func (p *MultiSigPublicKey) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
+	intentMessage := models.NewPersonalMessageIntentMessage(message)
+	return p.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+}
+
+// VerifyTransaction verifies the serialized multisig signature over the transaction data bytes.
+func (p *MultiSigPublicKey) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	return p.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+}
+
+// VerifyWithIntent verifies every partial signature of the serialized multisig signature and
+// checks that the weight of the signers reaches the threshold.
+func (p *MultiSigPublicKey) VerifyWithIntent(data []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	parsed, err := ParseSerializedMultiSigSignature(signature)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(parsed.PubKey, p.data) {
+		return false, nil
+	}
+
+	partialSignatures, err := p.partialSignatures(parsed.MultiSig)
+	if err != nil {
+		return false, err
+	}
+
+	var weight uint16
+	for _, partial := range partialSignatures {
+		publicKey, err := p.memberPublicKey(partial.SignatureScheme, partial.PubKey)
+		if err != nil {
+			return false, err
+		}
+		pass, err := publicKey.VerifyWithIntent(data, partial.Signature, scope)
+		if err != nil || !pass {
+			return false, err
+		}
+		weight += uint16(partial.Weight)
+	}
+
+	return weight >= p.multisigPublicKey.Threshold, nil
+}
+
+// PartialSignature is a member signature of a multisig signature.
+type PartialSignature struct {
+	SignatureScheme scheme.SignatureScheme
+	Signature       []byte
+	PubKey          []byte
+	Weight          uint8
+}
+
+// partialSignatures matches the signatures with the public keys set in the bitmap.
+func (p *MultiSigPublicKey) partialSignatures(multiSig *MultiSigStruct) ([]PartialSignature, error) {
+	if bits.OnesCount16(multiSig.Bitmap) != len(multiSig.Sigs) {
+		return nil, errors.New("invalid multisig bitmap")
+	}
+
+	partials := make([]PartialSignature, 0, len(multiSig.Sigs))
+	bitmap := multiSig.Bitmap
+	for _, sig := range multiSig.Sigs {
+		index := bits.TrailingZeros16(bitmap)
+		bitmap &= bitmap - 1
+		if index >= len(p.publicKeys) {
+			return nil, errors.New("invalid multisig bitmap")
+		}
+
+		signatureScheme, signature, err := sig.schemeAndBytes()
+		if err != nil {
+			return nil, err
+		}
+		pk := p.publicKeys[index]
+		if signatureScheme != pk.SignatureScheme {
+			return nil, errors.New("multisig signature scheme does not match the public key")
+		}
+		partials = append(partials, PartialSignature{
+			SignatureScheme: signatureScheme,
+			Signature:       signature,
+			PubKey:          pk.PubKey,
+			Weight:          pk.Weight,
+		})
+	}
+
+	return partials, nil
+}
+
+type intentVerifier interface {
+	VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error)
+}
+
+func (p *MultiSigPublicKey) memberPublicKey(signatureScheme scheme.SignatureScheme, pubKey []byte) (intentVerifier, error) {
+	switch signatureScheme {
+	case scheme.ED25519:
+		return ed25519.NewEd25519PublicKey(pubKey), nil
+	case scheme.Secp256k1:
+		return secp256k1.NewSecp256k1PublicKey(pubKey), nil
+	case scheme.Secp256r1:
+		return secp256r1.NewSecp256r1PublicKey(pubKey), nil
+	case scheme.ZkLogin:
+		return zklogin.NewZkLoginPublicIdentifier(pubKey, p.options), nil
+	case scheme.Passkey:
+		return passkey.NewPasskeyPublicKey(pubKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+}
+
+// NewMultiSigPublicKeyFromPublicKeys builds a k-of-n multisig public key from weighted member keys.
+func NewMultiSigPublicKeyFromPublicKeys(publicKeys []PubkeyWeightPair, threshold uint16, options *zklogin.ZkLoginPublicIdentifierOptions) (*MultiSigPublicKey, error) {
+	pkMap := make([]MultiSigPkMap, 0, len(publicKeys))
+	for _, pk := range publicKeys {
+		pubKey, err := newPublicKey(pk.SignatureScheme, pk.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pkMap = append(pkMap, MultiSigPkMap{PubKey: pubKey, Weight: pk.Weight})
+	}
+
+	multisigPublicKey := MultiSigPublicKeyStruct{
+		PkMap:     pkMap,
+		Threshold: threshold,
+	}
+	data, err := mystenbcs.Marshal(&multisigPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMultiSigPublicKey(data, multisigPublicKey, options)
+}
+
+// CombinePartialSignatures combines the base64 serialized signatures of the members into a
+// base64 serialized multisig signature. The signatures may be passed in any order.
+func (p *MultiSigPublicKey) CombinePartialSignatures(signatures []string) (string, error) {
+	if len(signatures) == 0 {
+		return "", errors.New("no signatures to combine")
+	}
+
+	var bitmap uint16
+	compressed := make(map[int]CompressedSignature, len(signatures))
+	for _, signature := range signatures {
+		signatureScheme, sig, pubKey, err := parsePartialSignature(signature)
+		if err != nil {
+			return "", err
+		}
+
+		index := -1
+		for i, pk := range p.publicKeys {
+			if pk.SignatureScheme == signatureScheme && bytes.Equal(pk.PubKey, pubKey) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return "", errors.New("received signature from unknown public key")
+		}
+		if bitmap&(1<<index) != 0 {
+			return "", errors.New("received multiple signatures from the same public key")
+		}
+		bitmap |= 1 << index
+
+		compressedSignature, err := newCompressedSignature(signatureScheme, sig)
+		if err != nil {
+			return "", err
+		}
+		compressed[index] = compressedSignature
+	}
+
+	// signatures are ordered by the index of their public key, as the bitmap is read in ascending order
+	sigs := make([]CompressedSignature, 0, len(compressed))
+	for i := range p.publicKeys {
+		if sig, ok := compressed[i]; ok {
+			sigs = append(sigs, sig)
+		}
+	}
+
+	bcsBytes, err := mystenbcs.Marshal(&MultiSigStruct{
+		Sigs:       sigs,
+		Bitmap:     bitmap,
+		MultisigPk: p.multisigPublicKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return mystenbcs.ToBase64(append([]byte{scheme.SignatureSchemeToFlag[scheme.MultiSig]}, bcsBytes...)), nil
+}
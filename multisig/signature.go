@@ -0,0 +1,190 @@
+package multisig
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/passkey"
+	"github.com/block-vision/sui-go-sdk/zklogin"
+)
+
+// maxMultiSigSignatureSize bounds the bcs of a multisig, whose signatures may be zkLogin ones.
+const maxMultiSigSignatureSize = 128 * 1024
+
+type ParsedMultiSigSignature struct {
+	SerializedSignature string
+	SignatureScheme     scheme.SignatureScheme
+	// Signature is the full serialized signature, including the flag.
+	Signature []byte
+	MultiSig  *MultiSigStruct
+	// PubKey is the bcs encoded multisig public key.
+	PubKey []byte
+}
+
+// ParseSerializedMultiSigSignature parses a serialized multisig signature `0x03 || bcs(MultiSig)`.
+func ParseSerializedMultiSigSignature(signature []byte) (*ParsedMultiSigSignature, error) {
+	if len(signature) == 0 || signature[0] != scheme.SignatureSchemeToFlag[scheme.MultiSig] {
+		return nil, errors.New("invalid signature scheme")
+	}
+
+	var multiSig MultiSigStruct
+	_, err := mystenbcs.UnmarshalWithOptions(signature[1:], &multiSig, &mystenbcs.DecodeOptions{
+		MaxBytes:            maxMultiSigSignatureSize,
+		RejectTrailingBytes: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse multisig: %v", err)
+	}
+
+	pubKey, err := mystenbcs.Marshal(&multiSig.MultisigPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParsedMultiSigSignature{
+		SerializedSignature: mystenbcs.ToBase64(signature),
+		SignatureScheme:     scheme.MultiSig,
+		Signature:           signature,
+		MultiSig:            &multiSig,
+		PubKey:              pubKey,
+	}, nil
+}
+
+// schemeAndBytes returns the signature scheme and the raw bytes of the compressed signature.
+func (s CompressedSignature) schemeAndBytes() (scheme.SignatureScheme, []byte, error) {
+	switch {
+	case s.ED25519 != nil:
+		return scheme.ED25519, s.ED25519[:], nil
+	case s.Secp256k1 != nil:
+		return scheme.Secp256k1, s.Secp256k1[:], nil
+	case s.Secp256r1 != nil:
+		return scheme.Secp256r1, s.Secp256r1[:], nil
+	case s.ZkLogin != nil:
+		return scheme.ZkLogin, *s.ZkLogin, nil
+	case s.Passkey != nil:
+		return scheme.Passkey, *s.Passkey, nil
+	default:
+		return "", nil, errors.New("empty compressed signature")
+	}
+}
+
+// schemeAndBytes returns the signature scheme and the raw bytes of the public key.
+func (pk PublicKey) schemeAndBytes() (scheme.SignatureScheme, []byte, error) {
+	switch {
+	case pk.ED25519 != nil:
+		return scheme.ED25519, pk.ED25519[:], nil
+	case pk.Secp256k1 != nil:
+		return scheme.Secp256k1, pk.Secp256k1[:], nil
+	case pk.Secp256r1 != nil:
+		return scheme.Secp256r1, pk.Secp256r1[:], nil
+	case pk.ZkLogin != nil:
+		return scheme.ZkLogin, *pk.ZkLogin, nil
+	case pk.Passkey != nil:
+		return scheme.Passkey, pk.Passkey[:], nil
+	default:
+		return "", nil, errors.New("empty public key")
+	}
+}
+
+// parsePartialSignature parses a serialized member signature, and returns its scheme, the
+// signature stored in the multisig and the public key of the member.
+func parsePartialSignature(signature string) (scheme.SignatureScheme, []byte, []byte, error) {
+	bytes, err := mystenbcs.FromBase64(signature)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(bytes) == 0 {
+		return "", nil, nil, errors.New("empty signature")
+	}
+
+	signatureScheme, ok := scheme.SignatureFlagToScheme[bytes[0]]
+	if !ok {
+		return "", nil, nil, errors.New("signature flag is not supported")
+	}
+
+	switch signatureScheme {
+	case scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1:
+		size := scheme.SignatureSchemeToSize[signatureScheme]
+		if len(bytes) != 1+64+size {
+			return "", nil, nil, fmt.Errorf("invalid %s signature length: %d", signatureScheme, len(bytes))
+		}
+		return signatureScheme, bytes[1 : 1+64], bytes[1+64:], nil
+	case scheme.ZkLogin:
+		parsed, err := zklogin.ParseSerializedZkLoginSignature(bytes)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return signatureScheme, parsed.Signature, parsed.PubKey, nil
+	case scheme.Passkey:
+		parsed, err := passkey.ParseSerializedPasskeySignature(bytes)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return signatureScheme, parsed.Signature, parsed.PubKey, nil
+	default:
+		return "", nil, nil, fmt.Errorf("%s signatures cannot be nested in a multisig", signatureScheme)
+	}
+}
+
+func newCompressedSignature(signatureScheme scheme.SignatureScheme, signature []byte) (CompressedSignature, error) {
+	var compressed CompressedSignature
+	switch signatureScheme {
+	case scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1:
+		var sig [64]byte
+		if len(signature) != len(sig) {
+			return compressed, fmt.Errorf("invalid %s signature length: %d", signatureScheme, len(signature))
+		}
+		copy(sig[:], signature)
+		switch signatureScheme {
+		case scheme.ED25519:
+			compressed.ED25519 = &sig
+		case scheme.Secp256k1:
+			compressed.Secp256k1 = &sig
+		default:
+			compressed.Secp256r1 = &sig
+		}
+	case scheme.ZkLogin:
+		compressed.ZkLogin = &signature
+	case scheme.Passkey:
+		compressed.Passkey = &signature
+	default:
+		return compressed, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+
+	return compressed, nil
+}
+
+func newPublicKey(signatureScheme scheme.SignatureScheme, pubKey []byte) (PublicKey, error) {
+	var pk PublicKey
+	switch signatureScheme {
+	case scheme.ED25519:
+		var key [32]byte
+		if len(pubKey) != len(key) {
+			return pk, fmt.Errorf("invalid %s public key length: %d", signatureScheme, len(pubKey))
+		}
+		copy(key[:], pubKey)
+		pk.ED25519 = &key
+	case scheme.Secp256k1, scheme.Secp256r1, scheme.Passkey:
+		var key [33]byte
+		if len(pubKey) != len(key) {
+			return pk, fmt.Errorf("invalid %s public key length: %d", signatureScheme, len(pubKey))
+		}
+		copy(key[:], pubKey)
+		switch signatureScheme {
+		case scheme.Secp256k1:
+			pk.Secp256k1 = &key
+		case scheme.Secp256r1:
+			pk.Secp256r1 = &key
+		default:
+			pk.Passkey = &key
+		}
+	case scheme.ZkLogin:
+		pk.ZkLogin = &pubKey
+	default:
+		return pk, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+
+	return pk, nil
+}
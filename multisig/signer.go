@@ -0,0 +1,74 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// MultiSigSigner signs for a multisig address with the given member signers, which together
+// must reach the threshold of the multisig public key.
+type MultiSigSigner struct {
+	publicKey *MultiSigPublicKey
+	signers   []models.Signer
+}
+
+var _ models.Signer = (*MultiSigSigner)(nil)
+
+// NewMultiSigSigner creates a signer from the multisig public key and a subset of its members.
+func NewMultiSigSigner(publicKey *MultiSigPublicKey, signers []models.Signer) (*MultiSigSigner, error) {
+	var weight uint16
+	for _, s := range signers {
+		found := false
+		for _, pk := range publicKey.publicKeys {
+			if pk.SignatureScheme == s.GetKeyScheme() && bytes.Equal(pk.PubKey, s.GetPublicKey()) {
+				weight += uint16(pk.Weight)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("signer is not a member of the multisig public key")
+		}
+	}
+	if weight < publicKey.GetThreshold() {
+		return nil, errors.New("signers do not reach the multisig threshold")
+	}
+
+	return &MultiSigSigner{publicKey: publicKey, signers: signers}, nil
+}
+
+func (s *MultiSigSigner) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.MultiSig
+}
+
+func (s *MultiSigSigner) GetPublicKey() []byte {
+	return s.publicKey.ToRawBytes()
+}
+
+func (s *MultiSigSigner) ToSuiAddress() string {
+	return s.publicKey.ToSuiAddress()
+}
+
+// Sign collects a signature from every member signer and returns the serialized multisig signature.
+func (s *MultiSigSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	signatures := make([]string, 0, len(s.signers))
+	for _, member := range s.signers {
+		signature, err := models.SignDigest(ctx, member, digest)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	multiSig, err := s.publicKey.CombinePartialSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return mystenbcs.FromBase64(multiSig)
+}
@@ -0,0 +1,731 @@
+// Package bcsgen generates reflection-free BCS methods for the Go types annotated with the
+// `//bcs:generate` directive, see [Generate].
+package bcsgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Directive annotates the types the methods are generated for.
+	Directive = "//bcs:generate"
+	// FileName is the default name of the generated file, it is ignored when loading the package.
+	FileName = "bcs_generated.go"
+
+	mystenbcsImport = "github.com/block-vision/sui-go-sdk/mystenbcs"
+	header          = "// Code generated by bcsgen. DO NOT EDIT."
+)
+
+type generator struct {
+	pkg *types.Package
+	// annotated are the types of the package the methods are generated for.
+	annotated map[*types.TypeName]bool
+	imports   map[string]bool
+
+	marshaler, unmarshaler, enum, generated *types.Interface
+
+	// inlined are the types being inlined, a recursive type must be annotated.
+	inlined map[*types.Named]bool
+	tmp     int
+	usesErr bool
+}
+
+// Generate generates the BCS methods of the annotated types of the package in dir:
+//
+//   - MarshalBCS and UnmarshalBCS, the mystenbcs Marshaler and Unmarshaler
+//   - AppendBCS and DecodeBCS, the mystenbcs.Generated methods called by the generated methods
+//     of the types containing them
+//
+// The methods encode and decode values as the reflective mystenbcs path, the values of interfaces
+// are still encoded and decoded with it. Types which aren't annotated are inlined in the methods
+// of the annotated types containing them, unless they implement the mystenbcs interfaces.
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	buildPkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, name := range buildPkg.GoFiles {
+		if name == FileName {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check(buildPkg.Name, fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	mystenbcs, err := config.Importer.Import(mystenbcsImport)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		pkg:         pkg,
+		annotated:   make(map[*types.TypeName]bool),
+		imports:     make(map[string]bool),
+		marshaler:   lookupInterface(mystenbcs, "Marshaler"),
+		unmarshaler: lookupInterface(mystenbcs, "Unmarshaler"),
+		enum:        lookupInterface(mystenbcs, "Enum"),
+		generated:   lookupInterface(mystenbcs, "Generated"),
+		inlined:     make(map[*types.Named]bool),
+	}
+
+	var names []*types.TypeName
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if !hasDirective(typeSpec.Doc) && !(len(genDecl.Specs) == 1 && hasDirective(genDecl.Doc)) {
+					continue
+				}
+				name := pkg.Scope().Lookup(typeSpec.Name.Name).(*types.TypeName)
+				if typeSpec.TypeParams != nil || name.IsAlias() {
+					return nil, fmt.Errorf("%s: generic types and aliases are not supported", name.Name())
+				}
+				g.annotated[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no type of package %s is annotated with %s", pkg.Name(), Directive)
+	}
+
+	var body bytes.Buffer
+	for _, name := range names {
+		if err := g.writeMethods(&body, name); err != nil {
+			return nil, fmt.Errorf("%s: %v", name.Name(), err)
+		}
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "%s\n\npackage %s\n\n", header, pkg.Name())
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	// the standard packages first
+	sort.Slice(paths, func(i, j int) bool {
+		iStd, jStd := !strings.Contains(paths[i], "."), !strings.Contains(paths[j], ".")
+		if iStd != jStd {
+			return iStd
+		}
+		return paths[i] < paths[j]
+	})
+	source.WriteString("import (\n")
+	for i, path := range paths {
+		if i > 0 && strings.Contains(path, ".") && !strings.Contains(paths[i-1], ".") {
+			source.WriteString("\n")
+		}
+		fmt.Fprintf(&source, "\t%q\n", path)
+	}
+	source.WriteString(")\n\n")
+	source.Write(body.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated code: %v\n%s", err, source.Bytes())
+	}
+	return formatted, nil
+}
+
+func lookupInterface(pkg *types.Package, name string) *types.Interface {
+	return pkg.Scope().Lookup(name).Type().Underlying().(*types.Interface)
+}
+
+func hasDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == Directive {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) writeMethods(w *bytes.Buffer, name *types.TypeName) error {
+	g.imports["io"] = true
+	g.imports[mystenbcsImport] = true
+	typeName := name.Name()
+	named := name.Type().(*types.Named)
+	for i := 0; i < named.NumMethods(); i++ {
+		switch method := named.Method(i).Name(); method {
+		case "MarshalBCS", "UnmarshalBCS", "AppendBCS", "DecodeBCS":
+			return fmt.Errorf("it already has a %s method", method)
+		}
+	}
+	// the root value of the methods is the receiver
+	root := "(*v)"
+	if _, ok := named.Underlying().(*types.Struct); ok {
+		root = "v"
+	}
+
+	g.tmp, g.usesErr = 0, false
+	var encode bytes.Buffer
+	if err := g.encodeType(&encode, named, root); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "func (v %s) MarshalBCS() ([]byte, error) {\nreturn v.AppendBCS(nil)\n}\n\n", typeName)
+	fmt.Fprintf(w, "func (v *%s) AppendBCS(b []byte) ([]byte, error) {\n", typeName)
+	if g.usesErr {
+		w.WriteString("var err error\n")
+	}
+	w.Write(encode.Bytes())
+	w.WriteString("return b, nil\n}\n\n")
+
+	g.tmp = 0
+	var decode bytes.Buffer
+	if err := g.decodeType(&decode, named, root); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "func (v *%s) UnmarshalBCS(r io.Reader) (int, error) {\n", typeName)
+	w.WriteString("br := mystenbcs.NewReader(r)\nv.DecodeBCS(br)\nreturn br.N(), br.Err()\n}\n\n")
+	fmt.Fprintf(w, "func (v *%s) DecodeBCS(r *mystenbcs.Reader) {\n", typeName)
+	w.WriteString("if r.Err() != nil {\nreturn\n}\n")
+	w.Write(decode.Bytes())
+	w.WriteString("}\n\n")
+	return nil
+}
+
+func (g *generator) next(prefix string) string {
+	g.tmp++
+	return fmt.Sprintf("%s%d", prefix, g.tmp)
+}
+
+// typeString returns the Go type, importing its packages.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		g.imports[p.Path()] = true
+		return p.Name()
+	})
+}
+
+// isGenerated reports whether the pointer of the type has generated methods.
+func (g *generator) isGenerated(t types.Type) bool {
+	if named, ok := t.(*types.Named); ok && g.annotated[named.Obj()] {
+		return true
+	}
+	if _, ok := t.Underlying().(*types.Pointer); ok {
+		return false
+	}
+	return types.Implements(types.NewPointer(t), g.generated)
+}
+
+// field selects a field of a struct value.
+func field(x, name string) string {
+	return receiver(x) + "." + name
+}
+
+// receiver returns the pointer of a dereference, to call a method.
+func receiver(x string) string {
+	if strings.HasPrefix(x, "(*") && strings.HasSuffix(x, ")") {
+		return x[2 : len(x)-1]
+	}
+	return x
+}
+
+// address returns the address of the value.
+func address(x string) string {
+	if strings.HasPrefix(x, "(*") && strings.HasSuffix(x, ")") {
+		return x[2 : len(x)-1]
+	}
+	return "&" + x
+}
+
+// unparen removes the parentheses of a dereference, where they aren't needed.
+func unparen(x string) string {
+	if strings.HasPrefix(x, "(*") && strings.HasSuffix(x, ")") {
+		return x[1 : len(x)-1]
+	}
+	return x
+}
+
+// encodeValue writes the encoding of the addressable value x of type t, as the reflective
+// encoder: a mystenbcs.Marshaler, a mystenbcs.Enum or a value of its kind.
+func (g *generator) encodeValue(w *bytes.Buffer, t types.Type, x string) error {
+	if err := g.checkEnumValue(t); err != nil {
+		return err
+	}
+	if g.isGenerated(t) || isPointerTo(t, g.isGenerated) {
+		g.usesErr = true
+		fmt.Fprintf(w, "if b, err = %s.AppendBCS(b); err != nil {\nreturn b, err\n}\n", receiver(x))
+		return nil
+	}
+	if types.Implements(t, g.marshaler) {
+		data := g.next("data")
+		g.usesErr = true
+		fmt.Fprintf(w, "%s, err := %s.MarshalBCS()\nif err != nil {\nreturn b, err\n}\nb = append(b, %s...)\n", data, receiver(x), data)
+		return nil
+	}
+	return g.encodeType(w, t, x)
+}
+
+func isPointerTo(t types.Type, is func(types.Type) bool) bool {
+	pointer, ok := t.Underlying().(*types.Pointer)
+	return ok && is(pointer.Elem())
+}
+
+// encodeType writes the encoding of x as a mystenbcs.Enum or a value of its kind.
+func (g *generator) encodeType(w *bytes.Buffer, t types.Type, x string) error {
+	if g.isEnum(t) {
+		if pointer, ok := t.Underlying().(*types.Pointer); ok {
+			return g.encodeEnum(w, pointer.Elem(), "(*"+x+")")
+		}
+		return g.encodeEnum(w, t, x)
+	}
+	if named, ok := t.(*types.Named); ok {
+		if g.inlined[named] {
+			return fmt.Errorf("the recursive type %s must be annotated", g.typeString(named))
+		}
+		g.inlined[named] = true
+		defer delete(g.inlined, named)
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.Bool:
+			fmt.Fprintf(w, "if %s {\nb = append(b, 1)\n} else {\nb = append(b, 0)\n}\n", x)
+		case types.Int8, types.Uint8:
+			fmt.Fprintf(w, "b = append(b, byte(%s))\n", unparen(x))
+		case types.Int16, types.Uint16, types.Int32, types.Uint32, types.Int64, types.Uint64:
+			g.imports["encoding/binary"] = true
+			bits := strconv.FormatInt(types.SizesFor("gc", "amd64").Sizeof(u)*8, 10)
+			fmt.Fprintf(w, "b = binary.LittleEndian.AppendUint%s(b, uint%s(%s))\n", bits, bits, unparen(x))
+		case types.String:
+			fmt.Fprintf(w, "b = mystenbcs.AppendULEB128(b, len(%s))\nb = append(b, %s...)\n", unparen(x), unparen(x))
+		default:
+			return fmt.Errorf("unsupported type %s", g.typeString(t))
+		}
+
+	case *types.Pointer:
+		// a nil pointer is not encoded
+		fmt.Fprintf(w, "if %s != nil {\n", unparen(x))
+		if err := g.encodeValue(w, u.Elem(), "(*"+x+")"); err != nil {
+			return err
+		}
+		w.WriteString("}\n")
+
+	case *types.Interface:
+		g.usesErr = true
+		fmt.Fprintf(w, "if b, err = mystenbcs.Append(b, %s); err != nil {\nreturn b, err\n}\n", x)
+
+	case *types.Struct:
+		return g.encodeStruct(w, u, x)
+
+	case *types.Slice:
+		fmt.Fprintf(w, "b = mystenbcs.AppendULEB128(b, len(%s))\n", unparen(x))
+		if isByte(u.Elem()) {
+			fmt.Fprintf(w, "b = append(b, %s...)\n", unparen(x))
+			return nil
+		}
+		i := g.next("i")
+		fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+		if err := g.encodeValue(w, u.Elem(), x+"["+i+"]"); err != nil {
+			return err
+		}
+		w.WriteString("}\n")
+
+	case *types.Array:
+		if isByte(u.Elem()) {
+			fmt.Fprintf(w, "b = append(b, %s[:]...)\n", x)
+			return nil
+		}
+		i := g.next("i")
+		fmt.Fprintf(w, "for %s := range %s {\n", i, x)
+		if err := g.encodeValue(w, u.Elem(), x+"["+i+"]"); err != nil {
+			return err
+		}
+		w.WriteString("}\n")
+
+	case *types.Chan, *types.Signature:
+		// ignored
+	default:
+		return fmt.Errorf("unsupported type %s", g.typeString(t))
+	}
+	return nil
+}
+
+func (g *generator) encodeStruct(w *bytes.Buffer, s *types.Struct, x string) error {
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		tag, err := parseTag(s.Tag(i))
+		if err != nil {
+			return err
+		}
+		value := field(x, f.Name())
+		switch {
+		case tag.ignored:
+		case tag.optional:
+			pointer, ok := f.Type().Underlying().(*types.Pointer)
+			if !ok {
+				return fmt.Errorf("optional field %s must be a pointer", f.Name())
+			}
+			fmt.Fprintf(w, "if %s == nil {\nb = append(b, 0)\n} else {\nb = append(b, 1)\n", value)
+			if err := g.encodeValue(w, pointer.Elem(), "(*"+value+")"); err != nil {
+				return err
+			}
+			w.WriteString("}\n")
+		default:
+			if err := g.encodeValue(w, f.Type(), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) encodeEnum(w *bytes.Buffer, t types.Type, x string) error {
+	s, variants, err := g.enumVariants(t)
+	if err != nil {
+		return err
+	}
+	w.WriteString("switch {\n")
+	for _, variant := range variants {
+		f := s.Field(variant.field)
+		value := field(x, f.Name())
+		fmt.Fprintf(w, "case %s != nil:\nb = mystenbcs.AppendULEB128(b, %d)\n", value, variant.index)
+		if pointer, ok := f.Type().Underlying().(*types.Pointer); ok {
+			if err := g.encodeValue(w, pointer.Elem(), "(*"+value+")"); err != nil {
+				return err
+			}
+		} else {
+			g.usesErr = true
+			fmt.Fprintf(w, "if b, err = mystenbcs.Append(b, %s); err != nil {\nreturn b, err\n}\n", value)
+		}
+	}
+	w.WriteString("default:\nreturn b, mystenbcs.ErrEmptyEnum\n}\n")
+	return nil
+}
+
+// decodeValue writes the decoding of the settable value x of type t, as the reflective decoder: a
+// nil pointer is allocated, then it is decoded as a mystenbcs.Unmarshaler, a mystenbcs.Enum or a
+// value of its kind.
+func (g *generator) decodeValue(w *bytes.Buffer, t types.Type, x string) error {
+	if err := g.checkEnumValue(t); err != nil {
+		return err
+	}
+	if pointer, ok := t.Underlying().(*types.Pointer); ok {
+		fmt.Fprintf(w, "if %s == nil {\n%s = new(%s)\n}\n", unparen(x), unparen(x), g.typeString(pointer.Elem()))
+	}
+	return g.decodeAllocated(w, t, x)
+}
+
+// decodeAllocated is decodeValue once a pointer is allocated.
+func (g *generator) decodeAllocated(w *bytes.Buffer, t types.Type, x string) error {
+	switch {
+	case g.isGenerated(t) || isPointerTo(t, g.isGenerated):
+		fmt.Fprintf(w, "%s.DecodeBCS(r)\n", receiver(x))
+		return nil
+	case types.Implements(t, g.unmarshaler):
+		fmt.Fprintf(w, "r.Unmarshal(%s)\n", unparen(x))
+		return nil
+	case types.Implements(types.NewPointer(t), g.unmarshaler):
+		fmt.Fprintf(w, "r.Unmarshal(%s)\n", address(x))
+		return nil
+	}
+	return g.decodeType(w, t, x)
+}
+
+// decodeType writes the decoding of x as a mystenbcs.Enum or a value of its kind.
+func (g *generator) decodeType(w *bytes.Buffer, t types.Type, x string) error {
+	if g.isEnum(t) {
+		if pointer, ok := t.Underlying().(*types.Pointer); ok {
+			return g.decodeEnum(w, pointer.Elem(), "(*"+x+")")
+		}
+		return g.decodeEnum(w, t, x)
+	}
+	if named, ok := t.(*types.Named); ok {
+		if g.inlined[named] {
+			return fmt.Errorf("the recursive type %s must be annotated", g.typeString(named))
+		}
+		g.inlined[named] = true
+		defer delete(g.inlined, named)
+	}
+	// conversion converts a value to the type of x
+	conversion := func(value string) string {
+		if _, ok := t.(*types.Basic); ok {
+			return value
+		}
+		return g.typeString(t) + "(" + value + ")"
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.Bool:
+			fmt.Fprintf(w, "%s = %s\n", unparen(x), conversion("r.ReadBool()"))
+		case types.Int8, types.Uint8, types.Int16, types.Uint16, types.Int32, types.Uint32, types.Int64, types.Uint64:
+			bits := strconv.FormatInt(types.SizesFor("gc", "amd64").Sizeof(u)*8, 10)
+			read := "r.ReadUint" + bits + "()"
+			if u.Kind() == types.Uint8 || u.Kind() == types.Uint16 || u.Kind() == types.Uint32 || u.Kind() == types.Uint64 {
+				fmt.Fprintf(w, "%s = %s\n", unparen(x), conversion(read))
+			} else {
+				fmt.Fprintf(w, "%s = %s(%s)\n", unparen(x), g.typeString(t), read)
+			}
+		case types.String:
+			fmt.Fprintf(w, "%s = %s\n", unparen(x), conversion("r.ReadString()"))
+		default:
+			return fmt.Errorf("unsupported type %s", g.typeString(t))
+		}
+
+	case *types.Pointer:
+		return g.decodeValue(w, u.Elem(), "(*"+x+")")
+
+	case *types.Interface:
+		fmt.Fprintf(w, "r.Decode(&%s)\n", x)
+
+	case *types.Struct:
+		return g.decodeStruct(w, u, x)
+
+	case *types.Slice:
+		if isByte(u.Elem()) {
+			// an empty vector leaves the slice as is
+			data := g.next("data")
+			fmt.Fprintf(w, "if %s := r.ReadBytes(); %s != nil {\n%s = %s\n}\n", data, data, unparen(x), data)
+			return nil
+		}
+		size, slice, i, elem := g.next("size"), g.next("slice"), g.next("i"), g.next("elem")
+		// the length is not trusted, it doesn't size the allocation
+		fmt.Fprintf(w, "%s := r.ReadLen()\nr.Enter()\n%s := make(%s, 0, min(%s, mystenbcs.MaxPrealloc))\n", size, slice, g.typeString(t), size)
+		fmt.Fprintf(w, "for %s := 0; %s < %s && r.Err() == nil; %s++ {\n", i, i, size, i)
+		if pointer, ok := u.Elem().Underlying().(*types.Pointer); ok {
+			fmt.Fprintf(w, "%s := new(%s)\n", elem, g.typeString(pointer.Elem()))
+			if err := g.decodeAllocated(w, u.Elem(), elem); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintf(w, "var %s %s\n", elem, g.typeString(u.Elem()))
+			if err := g.decodeValue(w, u.Elem(), elem); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(w, "%s = append(%s, %s)\n}\nr.Leave()\n%s = %s\n", slice, slice, elem, unparen(x), slice)
+
+	case *types.Array:
+		if isByte(u.Elem()) {
+			fmt.Fprintf(w, "r.ReadFull(%s[:])\n", x)
+			return nil
+		}
+		i, elem := g.next("i"), g.next("elem")
+		fmt.Fprintf(w, "r.Enter()\nfor %s := range %s {\n", i, x)
+		// the elements are decoded as values, pointers are allocated
+		if pointer, ok := u.Elem().Underlying().(*types.Pointer); ok {
+			fmt.Fprintf(w, "%s := new(%s)\n", elem, g.typeString(pointer.Elem()))
+			if err := g.decodeValue(w, pointer.Elem(), "(*"+elem+")"); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintf(w, "var %s %s\n", elem, g.typeString(u.Elem()))
+			if err := g.decodeValue(w, u.Elem(), elem); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(w, "%s[%s] = %s\n}\nr.Leave()\n", x, i, elem)
+
+	case *types.Chan, *types.Signature:
+		// ignored
+	default:
+		return fmt.Errorf("unsupported type %s", g.typeString(t))
+	}
+	return nil
+}
+
+func (g *generator) decodeStruct(w *bytes.Buffer, s *types.Struct, x string) error {
+	w.WriteString("r.Enter()\n")
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		tag, err := parseTag(s.Tag(i))
+		if err != nil {
+			return err
+		}
+		value := field(x, f.Name())
+		switch {
+		case tag.ignored:
+		case tag.optional:
+			pointer, ok := f.Type().Underlying().(*types.Pointer)
+			if !ok {
+				return fmt.Errorf("optional field %s must be a pointer", f.Name())
+			}
+			fmt.Fprintf(w, "if r.ReadBool() {\n%s = new(%s)\n", value, g.typeString(pointer.Elem()))
+			if err := g.decodeValue(w, pointer.Elem(), "(*"+value+")"); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "} else {\n%s = nil\n}\n", value)
+		default:
+			if err := g.decodeValue(w, f.Type(), value); err != nil {
+				return err
+			}
+		}
+	}
+	w.WriteString("r.Leave()\n")
+	return nil
+}
+
+func (g *generator) decodeEnum(w *bytes.Buffer, t types.Type, x string) error {
+	s, variants, err := g.enumVariants(t)
+	if err != nil {
+		return err
+	}
+	index := g.next("index")
+	fmt.Fprintf(w, "r.Enter()\nswitch %s := r.ReadVariant(); %s {\n", index, index)
+	for _, variant := range variants {
+		f := s.Field(variant.field)
+		value := field(x, f.Name())
+		fmt.Fprintf(w, "case %d:\n", variant.index)
+		if _, ok := f.Type().Underlying().(*types.Pointer); ok {
+			if err := g.decodeValue(w, f.Type(), value); err != nil {
+				return err
+			}
+		} else {
+			// a nil interface is a variant without value
+			fmt.Fprintf(w, "if %s == nil {\n%s = struct{}{}\n} else {\nr.Decode(&%s)\n}\n", value, value, value)
+		}
+	}
+	fmt.Fprintf(w, "default:\nr.FailUnknownVariant(%s, %q)\n}\nr.Leave()\n", index, t.(*types.Named).Obj().Pkg().Name()+"."+t.(*types.Named).Obj().Name())
+	return nil
+}
+
+// isEnum reports whether the type is a mystenbcs.Enum struct, or a pointer to one. The methods
+// of an annotated type are those of its pointer, as its generated methods.
+func (g *generator) isEnum(t types.Type) bool {
+	elem := t
+	if pointer, ok := t.Underlying().(*types.Pointer); ok {
+		elem = pointer.Elem()
+	}
+	if _, ok := elem.Underlying().(*types.Struct); !ok {
+		return false
+	}
+	if named, ok := t.(*types.Named); ok && g.annotated[named.Obj()] {
+		return types.Implements(types.NewPointer(t), g.enum)
+	}
+	return types.Implements(t, g.enum)
+}
+
+// checkEnumValue checks an annotated type isn't an enum only by its pointer, the reflective path
+// encodes its values as structs but the generated methods as enums.
+func (g *generator) checkEnumValue(t types.Type) error {
+	named, ok := t.(*types.Named)
+	if !ok || !g.annotated[named.Obj()] || types.Implements(t, g.enum) || !g.isEnum(t) {
+		return nil
+	}
+	return fmt.Errorf("the enum %s is a value, which is encoded as a struct as its IsBcsEnum method has a pointer receiver, it can't be annotated", g.typeString(t))
+}
+
+type variant struct {
+	index int
+	field int
+}
+
+// enumVariants returns the variants of an enum in the order of their fields, as mystenbcs.
+func (g *generator) enumVariants(t types.Type) (*types.Struct, []variant, error) {
+	s := t.Underlying().(*types.Struct)
+	var variants []variant
+	fields := make(map[int]string)
+	next := 0
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		tag, err := parseTag(s.Tag(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		if tag.ignored {
+			continue
+		}
+		switch f.Type().Underlying().(type) {
+		case *types.Pointer, *types.Interface:
+		default:
+			return nil, nil, fmt.Errorf("the variant %s of enum %s is neither a pointer nor an interface", f.Name(), g.typeString(t))
+		}
+		if tag.unknown {
+			return nil, nil, fmt.Errorf("the unknown variant %s of enum %s is not supported", f.Name(), g.typeString(t))
+		}
+		index := next
+		if tag.variant >= 0 {
+			index = tag.variant
+		}
+		if other, ok := fields[index]; ok {
+			return nil, nil, fmt.Errorf("fields %s and %s of enum %s are both variant %d", other, f.Name(), g.typeString(t), index)
+		}
+		fields[index] = f.Name()
+		variants = append(variants, variant{index: index, field: i})
+		next = index + 1
+	}
+	return s, variants, nil
+}
+
+func isByte(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.Uint8
+}
+
+type tag struct {
+	optional, ignored, unknown bool
+	variant                    int
+}
+
+// parseTag parses a `bcs` struct tag as mystenbcs.
+func parseTag(structTag string) (tag, error) {
+	value := reflect.StructTag(structTag).Get("bcs")
+	t := tag{variant: -1}
+	for _, seg := range strings.Split(value, ",") {
+		seg = strings.TrimSpace(seg)
+		switch {
+		case seg == "":
+		case seg == "optional":
+			t.optional = true
+		case seg == "unknown":
+			t.unknown = true
+		case seg == "-":
+			return tag{ignored: true, variant: -1}, nil
+		case strings.HasPrefix(seg, "variant="):
+			variant, err := strconv.Atoi(strings.TrimPrefix(seg, "variant="))
+			if err != nil || variant < 0 {
+				return tag{}, fmt.Errorf("invalid variant index: %s in %s", seg, value)
+			}
+			t.variant = variant
+		default:
+			return tag{}, fmt.Errorf("unknown tag: %s in %s", seg, value)
+		}
+	}
+	return t, nil
+}
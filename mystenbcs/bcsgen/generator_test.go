@@ -0,0 +1,53 @@
+package bcsgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	source, err := Generate("testdata/valid")
+	require.NoError(t, err)
+	code := string(source)
+
+	require.Contains(t, code, "// Code generated by bcsgen. DO NOT EDIT.")
+	for _, method := range []string{
+		"func (v Owner) MarshalBCS() ([]byte, error)",
+		"func (v *Owner) AppendBCS(b []byte) ([]byte, error)",
+		"func (v *Owner) UnmarshalBCS(r io.Reader) (int, error)",
+		"func (v *Owner) DecodeBCS(r *mystenbcs.Reader)",
+		"func (v *Object) DecodeBCS(r *mystenbcs.Reader)",
+	} {
+		require.Contains(t, code, method)
+	}
+
+	// the variant indexes
+	require.Contains(t, code, "case v.Shared != nil:\n\t\tb = mystenbcs.AppendULEB128(b, 2)")
+	require.Contains(t, code, "case v.Immutable != nil:\n\t\tb = mystenbcs.AppendULEB128(b, 3)")
+	require.Contains(t, code, `r.FailUnknownVariant(index1, "valid.Owner")`)
+	// the annotated and the Unmarshaler types
+	require.Contains(t, code, "if b, err = v.Owner.AppendBCS(b); err != nil {")
+	require.Contains(t, code, "r.Unmarshal(&v.Balance)")
+	require.Contains(t, code, "v.Previous = new(Object)\n\t\tv.Previous.DecodeBCS(r)")
+	// ignored and unexported fields
+	require.NotContains(t, code, "Cache")
+	require.NotContains(t, code, "hidden")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		dir string
+		err string
+	}{
+		{dir: "testdata/enumvalue", err: "the enum Expiration is a value"},
+		{dir: "testdata/recursive", err: "the recursive type Node must be annotated"},
+		{dir: "testdata/missing", err: "cannot find package"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			_, err := Generate(tt.dir)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
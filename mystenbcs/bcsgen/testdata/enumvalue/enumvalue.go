@@ -0,0 +1,14 @@
+package enumvalue
+
+//bcs:generate
+type Expiration struct {
+	None  any
+	Epoch *uint64
+}
+
+func (*Expiration) IsBcsEnum() {}
+
+//bcs:generate
+type Data struct {
+	Expiration Expiration
+}
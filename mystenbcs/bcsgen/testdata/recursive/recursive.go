@@ -0,0 +1,10 @@
+package recursive
+
+type Node struct {
+	Children []Node
+}
+
+//bcs:generate
+type Tree struct {
+	Root Node
+}
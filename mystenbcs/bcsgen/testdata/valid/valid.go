@@ -0,0 +1,25 @@
+package valid
+
+import "github.com/block-vision/sui-go-sdk/mystenbcs"
+
+// Owner has a gap at variant 1.
+//
+//bcs:generate
+type Owner struct {
+	Address   *[32]byte
+	Shared    *uint64 `bcs:"variant=2"`
+	Immutable any
+}
+
+func (Owner) IsBcsEnum() {}
+
+//bcs:generate
+type Object struct {
+	Owner    Owner
+	Version  uint64
+	Balance  mystenbcs.U128
+	Previous *Object `bcs:"optional"`
+	Tags     []string
+	Cache    []byte `bcs:"-"`
+	hidden   int
+}
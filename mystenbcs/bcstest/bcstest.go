@@ -0,0 +1,173 @@
+// Package bcstest checks the BCS methods generated by bcsgen against the reflective mystenbcs path.
+package bcstest
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// maxDepth bounds the random values: deeper slices are empty, optional values are nil and enums
+// are their first variant.
+const maxDepth = 4
+
+var enumType = reflect.TypeFor[mystenbcs.Enum]()
+
+// Check encodes and decodes random values of T, and their truncations, with the generated methods
+// and with [mystenbcs.MarshalReflect] and [mystenbcs.UnmarshalReflect]. They must give the same
+// bytes and values, or both fail at the same offset. The values are also decoded within random
+// [mystenbcs.DecodeOptions].
+func Check[T any](t testing.TB, rng *rand.Rand, values int) {
+	t.Helper()
+	for i := 0; i < values; i++ {
+		value := new(T)
+		fill(rng, reflect.ValueOf(value).Elem(), 0)
+
+		generated, generatedErr := mystenbcs.Marshal(value)
+		reflected, reflectedErr := mystenbcs.MarshalReflect(value)
+		if (generatedErr == nil) != (reflectedErr == nil) {
+			t.Fatalf("value %d %+v: generated error %v, reflective error %v", i, value, generatedErr, reflectedErr)
+		}
+		if generatedErr != nil {
+			continue
+		}
+		if string(generated) != string(reflected) {
+			t.Fatalf("value %d %+v: generated bytes %x, reflective bytes %x", i, value, generated, reflected)
+		}
+
+		checkDecode[T](t, generated, nil)
+		checkDecode[T](t, generated[:rng.Intn(len(generated)+1)], nil)
+		checkDecode[T](t, generated, &mystenbcs.DecodeOptions{
+			MaxBytes:          rng.Intn(len(generated) + 2),
+			MaxSequenceLength: rng.Intn(4),
+			MaxDepth:          rng.Intn(maxDepth + 4),
+		})
+	}
+}
+
+func checkDecode[T any](t testing.TB, data []byte, options *mystenbcs.DecodeOptions) {
+	t.Helper()
+	generated, reflected := new(T), new(T)
+	generatedN, generatedErr := mystenbcs.UnmarshalWithOptions(data, generated, options)
+	reflectedN, reflectedErr := mystenbcs.UnmarshalReflect(data, reflected, options)
+	if (generatedErr == nil) != (reflectedErr == nil) {
+		t.Fatalf("%x %+v: generated error %v, reflective error %v", data, options, generatedErr, reflectedErr)
+	}
+	if generatedErr != nil {
+		var generatedAt, reflectedAt *mystenbcs.DecodeError
+		if !errors.As(generatedErr, &generatedAt) || !errors.As(reflectedErr, &reflectedAt) || generatedAt.Offset != reflectedAt.Offset {
+			t.Fatalf("%x %+v: generated error %v, reflective error %v", data, options, generatedErr, reflectedErr)
+		}
+		return
+	}
+	if generatedN != reflectedN {
+		t.Fatalf("%x: generated read %d bytes, reflective read %d bytes", data, generatedN, reflectedN)
+	}
+	if !reflect.DeepEqual(generated, reflected) {
+		t.Fatalf("%x: generated value %+v, reflective value %+v", data, generated, reflected)
+	}
+}
+
+// fill sets a random value which can be encoded: an enum has one variant, pointers are set unless
+// they are optional and interfaces are nil, except the unit variants of enums.
+func fill(rng *rand.Rand, v reflect.Value, depth int) {
+	t := v.Type()
+	if t.Kind() == reflect.Struct && (t.Implements(enumType) || reflect.PointerTo(t).Implements(enumType)) {
+		fillEnum(rng, v, depth)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rng.Intn(2) == 1)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(rng.Int63() - rng.Int63())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(rng.Uint64())
+	case reflect.String:
+		v.SetString(randomString(rng, depth))
+	case reflect.Pointer:
+		v.Set(reflect.New(t.Elem()))
+		fill(rng, v.Elem(), depth+1)
+	case reflect.Slice:
+		size := 0
+		if depth < maxDepth {
+			size = rng.Intn(4)
+		}
+		// an empty vector of bytes is decoded as nil
+		if size == 0 && t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		v.Set(reflect.MakeSlice(t, size, size))
+		for i := 0; i < size; i++ {
+			fill(rng, v.Index(i), depth+1)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fill(rng, v.Index(i), depth+1)
+		}
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			tag := field.Tag.Get("bcs")
+			if hasTag(tag, "-") || (hasTag(tag, "optional") && (depth >= maxDepth || rng.Intn(3) == 0)) {
+				continue
+			}
+			fill(rng, v.Field(i), depth)
+		}
+	}
+}
+
+func fillEnum(rng *rand.Rand, v reflect.Value, depth int) {
+	t := v.Type()
+	var variants []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("bcs")
+		if !field.IsExported() || hasTag(tag, "-") || hasTag(tag, "unknown") {
+			continue
+		}
+		variants = append(variants, i)
+	}
+	if len(variants) == 0 {
+		return
+	}
+	variant := variants[0]
+	if depth < maxDepth {
+		variant = variants[rng.Intn(len(variants))]
+	}
+
+	field := v.Field(variant)
+	if field.Kind() == reflect.Interface {
+		field.Set(reflect.ValueOf(struct{}{}))
+		return
+	}
+	fill(rng, field, depth+1)
+}
+
+func hasTag(tag, value string) bool {
+	for _, seg := range strings.Split(tag, ",") {
+		if strings.TrimSpace(seg) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func randomString(rng *rand.Rand, depth int) string {
+	if depth >= maxDepth {
+		return ""
+	}
+	b := make([]byte, rng.Intn(8))
+	for i := range b {
+		b[i] = byte('a' + rng.Intn(26))
+	}
+	return string(b)
+}
@@ -0,0 +1,215 @@
+package mystenbcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// U128 is a Move u128, encoded as 16 little-endian bytes. A nil Int is 0.
+//
+// Its JSON is a decimal string, as the node shows u128 values. It can be used as a struct field,
+// in a slice or as an optional pointer.
+type U128 struct {
+	*big.Int
+}
+
+// U256 is a Move u256, encoded as 32 little-endian bytes. A nil Int is 0.
+//
+// Its JSON is a decimal string, as the node shows u256 values. It can be used as a struct field,
+// in a slice or as an optional pointer.
+type U256 struct {
+	*big.Int
+}
+
+const (
+	u128Size = 16
+	u256Size = 32
+)
+
+// NewU128 returns the value as a U128, or an error if it is negative or exceeds 128 bits.
+func NewU128(value *big.Int) (U128, error) {
+	if err := checkUint(value, u128Size); err != nil {
+		return U128{}, err
+	}
+	return U128{new(big.Int).Set(value)}, nil
+}
+
+// U128FromUint64 returns the value as a U128.
+func U128FromUint64(value uint64) U128 {
+	return U128{new(big.Int).SetUint64(value)}
+}
+
+// ParseU128 parses a decimal u128.
+func ParseU128(value string) (U128, error) {
+	parsed, err := parseUint(value, u128Size)
+	if err != nil {
+		return U128{}, err
+	}
+	return U128{parsed}, nil
+}
+
+// NewU256 returns the value as a U256, or an error if it is negative or exceeds 256 bits.
+func NewU256(value *big.Int) (U256, error) {
+	if err := checkUint(value, u256Size); err != nil {
+		return U256{}, err
+	}
+	return U256{new(big.Int).Set(value)}, nil
+}
+
+// U256FromUint64 returns the value as a U256.
+func U256FromUint64(value uint64) U256 {
+	return U256{new(big.Int).SetUint64(value)}
+}
+
+// ParseU256 parses a decimal u256.
+func ParseU256(value string) (U256, error) {
+	parsed, err := parseUint(value, u256Size)
+	if err != nil {
+		return U256{}, err
+	}
+	return U256{parsed}, nil
+}
+
+// String returns the decimal value, 0 for a nil Int.
+func (u U128) String() string {
+	return uintString(u.Int)
+}
+
+func (u U128) MarshalBCS() ([]byte, error) {
+	return marshalUint(u.Int, u128Size)
+}
+
+func (u *U128) UnmarshalBCS(r io.Reader) (int, error) {
+	value, err := unmarshalUint(r, u128Size)
+	if err != nil {
+		return 0, err
+	}
+	u.Int = value
+	return u128Size, nil
+}
+
+func (u U128) MarshalJSON() ([]byte, error) {
+	return marshalUintJSON(u.Int, u128Size)
+}
+
+// UnmarshalJSON accepts a decimal string or a number.
+func (u *U128) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalUintJSON(data, u128Size)
+	if err != nil {
+		return err
+	}
+	u.Int = value
+	return nil
+}
+
+// String returns the decimal value, 0 for a nil Int.
+func (u U256) String() string {
+	return uintString(u.Int)
+}
+
+func (u U256) MarshalBCS() ([]byte, error) {
+	return marshalUint(u.Int, u256Size)
+}
+
+func (u *U256) UnmarshalBCS(r io.Reader) (int, error) {
+	value, err := unmarshalUint(r, u256Size)
+	if err != nil {
+		return 0, err
+	}
+	u.Int = value
+	return u256Size, nil
+}
+
+func (u U256) MarshalJSON() ([]byte, error) {
+	return marshalUintJSON(u.Int, u256Size)
+}
+
+// UnmarshalJSON accepts a decimal string or a number.
+func (u *U256) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalUintJSON(data, u256Size)
+	if err != nil {
+		return err
+	}
+	u.Int = value
+	return nil
+}
+
+func checkUint(value *big.Int, size int) error {
+	if value == nil {
+		return nil
+	}
+	if value.Sign() < 0 {
+		return fmt.Errorf("negative u%d %s", size*8, value)
+	}
+	if value.BitLen() > size*8 {
+		return fmt.Errorf("%s overflows u%d", value, size*8)
+	}
+	return nil
+}
+
+func parseUint(value string, size int) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid u%d %q", size*8, value)
+	}
+	if err := checkUint(parsed, size); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func uintString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
+
+func marshalUint(value *big.Int, size int) ([]byte, error) {
+	if err := checkUint(value, size); err != nil {
+		return nil, err
+	}
+	b := make([]byte, size)
+	if value == nil {
+		return b, nil
+	}
+	value.FillBytes(b)
+	reverse(b)
+	return b, nil
+}
+
+func unmarshalUint(r io.Reader, size int) (*big.Int, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	reverse(b)
+	return new(big.Int).SetBytes(b), nil
+}
+
+func marshalUintJSON(value *big.Int, size int) ([]byte, error) {
+	if err := checkUint(value, size); err != nil {
+		return nil, err
+	}
+	return json.Marshal(uintString(value))
+}
+
+func unmarshalUintJSON(data []byte, size int) (*big.Int, error) {
+	if string(data) == "null" {
+		return nil, nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		// a number
+		value = string(data)
+	}
+	return parseUint(value, size)
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
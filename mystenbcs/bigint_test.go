@@ -0,0 +1,139 @@
+package mystenbcs
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestU128(t *testing.T) {
+	tests := []struct {
+		value string
+		bcs   string
+	}{
+		{value: "0", bcs: "00000000000000000000000000000000"},
+		{value: "1", bcs: "01000000000000000000000000000000"},
+		{value: "18446744073709551616", bcs: "00000000000000000100000000000000"},
+		{value: "340282366920938463463374607431768211455", bcs: "ffffffffffffffffffffffffffffffff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, err := ParseU128(tt.value)
+			require.NoError(t, err)
+			data, err := Marshal(value)
+			require.NoError(t, err)
+			require.Equal(t, tt.bcs, hex.EncodeToString(data))
+
+			var decoded U128
+			n, err := Unmarshal(data, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, 16, n)
+			require.Equal(t, tt.value, decoded.String())
+		})
+	}
+
+	_, err := ParseU128("340282366920938463463374607431768211456")
+	require.Error(t, err)
+	_, err = ParseU128("-1")
+	require.Error(t, err)
+	_, err = NewU128(big.NewInt(-1))
+	require.Error(t, err)
+	_, err = Marshal(U128{big.NewInt(-1)})
+	require.Error(t, err)
+	_, err = Marshal(U128{new(big.Int).Lsh(big.NewInt(1), 128)})
+	require.Error(t, err)
+
+	// a nil Int is 0
+	data, err := Marshal(U128{})
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 16), data)
+	require.Equal(t, "0", U128{}.String())
+
+	_, err = Unmarshal(make([]byte, 15), &U128{})
+	require.Error(t, err)
+}
+
+func TestU256(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	value, err := NewU256(max)
+	require.NoError(t, err)
+	data, err := Marshal(value)
+	require.NoError(t, err)
+	require.Equal(t, 32, len(data))
+
+	var decoded U256
+	_, err = Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, 0, max.Cmp(decoded.Int))
+
+	data, err = Marshal(U256FromUint64(0x0102))
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{0x02, 0x01}, make([]byte, 30)...), data)
+
+	_, err = NewU256(new(big.Int).Add(max, big.NewInt(1)))
+	require.Error(t, err)
+}
+
+type pool struct {
+	Reserve U128
+	Prices  []U256
+	Limit   *U128 `bcs:"optional"`
+	Fee     uint64
+}
+
+func TestBigIntFields(t *testing.T) {
+	limit := U128FromUint64(7)
+	value := pool{
+		Reserve: U128FromUint64(1000),
+		Prices:  []U256{U256FromUint64(1), U256FromUint64(2)},
+		Limit:   &limit,
+		Fee:     30,
+	}
+	data, err := Marshal(&value)
+	require.NoError(t, err)
+	require.Equal(t, 16+1+2*32+1+16+8, len(data))
+
+	var decoded pool
+	n, err := Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, "1000", decoded.Reserve.String())
+	require.Equal(t, "2", decoded.Prices[1].String())
+	require.Equal(t, "7", decoded.Limit.String())
+	require.Equal(t, uint64(30), decoded.Fee)
+
+	value.Limit = nil
+	data, err = Marshal(&value)
+	require.NoError(t, err)
+	decoded = pool{}
+	_, err = Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded.Limit)
+}
+
+func TestBigIntJSON(t *testing.T) {
+	value := pool{
+		Reserve: U128FromUint64(1000),
+		Prices:  []U256{U256FromUint64(1)},
+	}
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Reserve":"1000","Prices":["1"],"Limit":null,"Fee":0}`, string(data))
+
+	var decoded pool
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, "1000", decoded.Reserve.String())
+	require.Equal(t, "1", decoded.Prices[0].String())
+
+	// numbers are accepted
+	var reserve U128
+	require.NoError(t, json.Unmarshal([]byte(`340282366920938463463374607431768211455`), &reserve))
+	require.Equal(t, "340282366920938463463374607431768211455", reserve.String())
+
+	require.Error(t, json.Unmarshal([]byte(`"340282366920938463463374607431768211456"`), &reserve))
+	require.Error(t, json.Unmarshal([]byte(`"-1"`), &reserve))
+	require.Error(t, json.Unmarshal([]byte(`"abc"`), &reserve))
+}
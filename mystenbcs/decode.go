@@ -19,19 +19,44 @@ import (
 //  2. if not [Unmarshaler] but [Enum], use the specialization for [Enum].
 //  3. otherwise standard process.
 func Unmarshal(data []byte, v any) (int, error) {
-	return NewDecoder(bytes.NewReader(data)).Decode(v)
+	return UnmarshalWithOptions(data, v, nil)
+}
+
+// UnmarshalWithOptions is [Unmarshal] within the limits of the options, to decode untrusted
+// input. Its errors are [*DecodeError] with the offset of the failure in the data.
+func UnmarshalWithOptions(data []byte, v any, options *DecodeOptions) (int, error) {
+	d := NewDecoderWithOptions(bytes.NewReader(data), options)
+	// the data is the value, an unknown enum variant at its end is the rest of the data
+	d.tail = true
+	return d.Decode(v)
 }
 
 // Decoder takes an [io.Reader] and decodes value from it.
 type Decoder struct {
-	reader     io.Reader
+	reader     *limitReader
 	byteBuffer [1]byte
+	// nested is true for a decoder created by an [Unmarshaler] with the reader of another one,
+	// which checks the trailing bytes and adds the offset to the errors.
+	nested bool
+	// tail is true when nothing follows the value being decoded, see [UnknownVariant].
+	tail bool
+	// reflectGenerated ignores the methods of [Generated] types, see [UnmarshalReflect].
+	reflectGenerated bool
 }
 
 // NewDecoder creates a new [Decoder] from an [io.Reader]
 func NewDecoder(r io.Reader) *Decoder {
+	return NewDecoderWithOptions(r, nil)
+}
+
+// NewDecoderWithOptions creates a [Decoder] within the limits of the options. The decoders created
+// by an [Unmarshaler] with the reader it is given share the limits of the decoder calling it, and
+// ignore their options.
+func NewDecoderWithOptions(r io.Reader, options *DecodeOptions) *Decoder {
+	reader, nested := newLimitReader(r, options)
 	return &Decoder{
-		reader: r,
+		reader: reader,
+		nested: nested,
 	}
 }
 
@@ -45,7 +70,14 @@ func (d *Decoder) Decode(v any) (int, error) {
 		return 0, fmt.Errorf("not a pointer or nil pointer")
 	}
 
-	return d.decode(reflectValue)
+	n, err := d.decode(reflectValue)
+	if d.nested {
+		return n, err
+	}
+	if err == nil && d.reader.options.RejectTrailingBytes {
+		err = d.reader.checkEOF()
+	}
+	return n, d.reader.wrap(err)
 }
 
 // decode is the main lifter, it first checks if a value can be [reflect.Value.CanInterface],
@@ -60,9 +92,21 @@ func (d *Decoder) decode(v reflect.Value) (int, error) {
 		return 0, nil
 	}
 
+	// allocate nil pointers, so that an Unmarshaler with a pointer receiver is not called on nil
+	if v.Kind() == reflect.Pointer && v.IsNil() && v.CanSet() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+
 	// Unmarshaler
-	if i, isUnmarshaler := v.Interface().(Unmarshaler); isUnmarshaler {
-		return i.UnmarshalBCS(d.reader)
+	generated := isGenerated(v.Type())
+	if i, isUnmarshaler := v.Interface().(Unmarshaler); isUnmarshaler && !(generated && d.reflectGenerated) {
+		return d.unmarshal(i, generated)
+	}
+	// Unmarshaler with a pointer receiver, e.g. a struct field
+	if v.Kind() != reflect.Pointer && v.CanAddr() && !(generated && d.reflectGenerated) {
+		if i, isUnmarshaler := v.Addr().Interface().(Unmarshaler); isUnmarshaler {
+			return d.unmarshal(i, generated)
+		}
 	}
 
 	// Enum
@@ -100,6 +144,18 @@ func (d *Decoder) decode(v reflect.Value) (int, error) {
 	}
 }
 
+// unmarshal calls the [Unmarshaler], its value is a level of nesting unless it is [Generated],
+// whose methods count the levels as the reflective path.
+func (d *Decoder) unmarshal(u Unmarshaler, generated bool) (int, error) {
+	if !generated {
+		if err := d.reader.enter(); err != nil {
+			return 0, err
+		}
+		defer d.reader.leave()
+	}
+	return u.UnmarshalBCS(d.reader)
+}
+
 // decodeVanilla decodes bool, ints, slice, struct, array, and string.
 func (d *Decoder) decodeVanilla(v reflect.Value) (int, error) {
 	kind := v.Kind()
@@ -159,7 +215,7 @@ func (d *Decoder) decodeVanilla(v reflect.Value) (int, error) {
 
 // decodeString
 func (d *Decoder) decodeString(v reflect.Value) (int, error) {
-	size, n, err := ULEB128Decode[int](d.reader)
+	size, n, err := d.reader.readLength()
 	if err != nil {
 		return n, err
 	}
@@ -169,18 +225,12 @@ func (d *Decoder) decodeString(v reflect.Value) (int, error) {
 		return n, nil
 	}
 
-	tmp := make([]byte, size)
-
-	read, err := d.reader.Read(tmp)
+	tmp, read, err := readBytes(d.reader, size)
 	n += read
 	if err != nil {
 		return n, err
 	}
 
-	if size != read {
-		return n, fmt.Errorf("wrong number of bytes read for string, want: %d, got %d", size, read)
-	}
-
 	v.SetString(string(tmp))
 
 	return n, nil
@@ -201,9 +251,16 @@ func (d *Decoder) readByte() (byte, int, error) {
 }
 
 func (d *Decoder) decodeStruct(v reflect.Value) (int, error) {
+	if err := d.reader.enter(); err != nil {
+		return 0, err
+	}
+	defer d.reader.leave()
 	t := v.Type()
 
 	var n int
+	tail := d.tail
+	defer func() { d.tail = tail }()
+	last := lastDecodedField(t)
 
 fieldLoop:
 	for i := 0; i < v.NumField(); i++ {
@@ -215,6 +272,7 @@ fieldLoop:
 		if err != nil {
 			return n, err
 		}
+		d.tail = tail && i == last
 
 		switch {
 		case tag.isIgnored(): // ignored
@@ -247,16 +305,55 @@ fieldLoop:
 	return n, nil
 }
 
+// lastDecodedField returns the index of the last field decoded in a struct, -1 if there is none.
+func lastDecodedField(t reflect.Type) int {
+	for i := t.NumField() - 1; i >= 0; i-- {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if tag, err := parseTagValue(field.Tag.Get(tagName)); err == nil && tag.isIgnored() {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
 func (d *Decoder) decodeEnum(v reflect.Value) (int, error) {
 	if v.Kind() != reflect.Struct {
 		return 0, fmt.Errorf("only support struct for Enum, got %s", v.Kind().String())
 	}
+	variants, err := enumVariantsOf(v.Type())
+	if err != nil {
+		return 0, err
+	}
+	if err := d.reader.enter(); err != nil {
+		return 0, err
+	}
+	defer d.reader.leave()
 	enumId, n, err := ULEB128Decode[int](d.reader)
 	if err != nil {
 		return n, err
 	}
 
-	field := v.Field(enumId)
+	i, ok := variants.fields[enumId]
+	if !ok {
+		if variants.unknown < 0 {
+			return n, fmt.Errorf("%w %d of %s", ErrUnknownVariant, enumId, v.Type())
+		}
+		if !d.tail {
+			return n, fmt.Errorf("%w %d of %s, its length is unknown as other values follow it", ErrUnknownVariant, enumId, v.Type())
+		}
+		data, err := io.ReadAll(d.reader)
+		n += len(data)
+		if err != nil {
+			return n, err
+		}
+		v.Field(variants.unknown).Set(reflect.ValueOf(&UnknownVariant{Index: enumId, Data: data}))
+		return n, nil
+	}
+	field := v.Field(i)
 
 	// Handle interface{} fields (like None any in TransactionExpiration)
 	// For empty enum variants, BCS only encodes the enum ID, no data follows
@@ -277,7 +374,7 @@ func (d *Decoder) decodeEnum(v reflect.Value) (int, error) {
 }
 
 func (d *Decoder) decodeByteSlice(v reflect.Value) (int, error) {
-	size, n, err := ULEB128Decode[int](d.reader)
+	size, n, err := d.reader.readLength()
 	if err != nil {
 		return n, err
 	}
@@ -286,18 +383,12 @@ func (d *Decoder) decodeByteSlice(v reflect.Value) (int, error) {
 		return n, nil
 	}
 
-	tmp := make([]byte, size)
-
-	read, err := d.reader.Read(tmp)
+	tmp, read, err := readBytes(d.reader, size)
 	n += read
 	if err != nil {
 		return n, err
 	}
 
-	if size != read {
-		return n, fmt.Errorf("wrong number of bytes read for []byte, want: %d, got %d", size, read)
-	}
-
 	v.Set(reflect.ValueOf(tmp))
 
 	return n, nil
@@ -310,17 +401,11 @@ func (d *Decoder) decodeByteArray(v reflect.Value) (int, error) {
 		return 0, nil
 	}
 
-	tmp := make([]byte, arraySize)
-
-	read, err := d.reader.Read(tmp)
+	tmp, read, err := readBytes(d.reader, arraySize)
 	if err != nil {
 		return read, err
 	}
 
-	if arraySize != read {
-		return read, fmt.Errorf("wrong number of bytes read for [%d]byte, want: %d, got %d", arraySize, arraySize, read)
-	}
-
 	for i := 0; i < arraySize; i++ {
 		v.Index(i).SetUint(uint64(tmp[i]))
 	}
@@ -333,9 +418,17 @@ func (d *Decoder) decodeArray(v reflect.Value) (int, error) {
 	t := v.Type()
 	elementType := t.Elem()
 
+	if err := d.reader.enter(); err != nil {
+		return 0, err
+	}
+	defer d.reader.leave()
+
 	var n int
+	tail := d.tail
+	defer func() { d.tail = tail }()
 	if elementType.Kind() == reflect.Pointer {
 		for i := 0; i < size; i++ {
+			d.tail = tail && i == size-1
 			idx := reflect.New(elementType.Elem())
 			k, err := d.decode(idx.Elem())
 			n += k
@@ -346,6 +439,7 @@ func (d *Decoder) decodeArray(v reflect.Value) (int, error) {
 		}
 	} else {
 		for i := 0; i < size; i++ {
+			d.tail = tail && i == size-1
 			idx := reflect.New(elementType)
 			k, err := d.decode(idx.Elem())
 			n += k
@@ -361,18 +455,25 @@ func (d *Decoder) decodeArray(v reflect.Value) (int, error) {
 
 func (d *Decoder) decodeSlice(v reflect.Value) (int, error) {
 	// get the length of the slice.
-	size, n, err := ULEB128Decode[int](d.reader)
+	size, n, err := d.reader.readLength()
 	if err != nil {
 		return n, err
 	}
+	if err := d.reader.enter(); err != nil {
+		return n, err
+	}
+	defer d.reader.leave()
 
 	// element type of the slice
 	elementType := v.Type().Elem()
-	// make a new slice
-	tmp := reflect.MakeSlice(v.Type(), 0, size)
+	// make a new slice, the length is not trusted
+	tmp := reflect.MakeSlice(v.Type(), 0, min(size, MaxPrealloc))
+	tail := d.tail
+	defer func() { d.tail = tail }()
 
 	if elementType.Kind() == reflect.Pointer {
 		for i := 0; i < size; i++ {
+			d.tail = tail && i == size-1
 			ind := reflect.New(elementType.Elem())
 			k, err := d.decode(ind)
 			n += k
@@ -383,6 +484,7 @@ func (d *Decoder) decodeSlice(v reflect.Value) (int, error) {
 		}
 	} else {
 		for i := 0; i < size; i++ {
+			d.tail = tail && i == size-1
 			ind := reflect.New(elementType)
 			k, err := d.decode(ind.Elem())
 			n += k
@@ -32,6 +32,8 @@ type Enum interface {
 // Encoder takes an [io.Writer] and encodes value into it.
 type Encoder struct {
 	w io.Writer
+	// reflectGenerated ignores the methods of [Generated] types, see [MarshalReflect].
+	reflectGenerated bool
 }
 
 // NewEncoder creates a new [Encoder] from an [io.Writer]
@@ -62,7 +64,7 @@ func (e *Encoder) encode(v reflect.Value) error {
 	// 1. Marshaler
 	// 2. Enum.
 	i := v.Interface()
-	if m, ismarshaler := i.(Marshaler); ismarshaler {
+	if m, ismarshaler := i.(Marshaler); ismarshaler && !(e.reflectGenerated && isGenerated(v.Type())) {
 		bytes, err := m.MarshalBCS()
 		if err != nil {
 			return err
@@ -120,43 +122,39 @@ func (e *Encoder) encode(v reflect.Value) error {
 	}
 }
 
-// encodeEnum encodes an [Enum]
+// encodeEnum encodes an [Enum], the index of the first field set followed by its value.
 func (e *Encoder) encodeEnum(v reflect.Value) error {
-	t := v.Type()
+	variants, err := enumVariantsOf(v.Type())
+	if err != nil {
+		return err
+	}
 
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
-		// ignore fields that are not exported
-		if !field.CanInterface() {
+		if field.IsNil() {
 			continue
 		}
 
-		fieldType := t.Field(i)
-		// check the tag
-		tag, err := parseTagValue(fieldType.Tag.Get(tagName))
-		if err != nil {
+		if i == variants.unknown {
+			unknown := field.Interface().(*UnknownVariant)
+			if _, err := e.w.Write(ULEB128Encode(unknown.Index)); err != nil {
+				return err
+			}
+			_, err := e.w.Write(unknown.Data)
 			return err
 		}
-		if tag.isIgnored() {
+
+		index, ok := variants.indexes[i]
+		if !ok {
 			continue
 		}
-		fieldKind := field.Kind()
-		if fieldKind != reflect.Pointer && fieldKind != reflect.Interface {
-			return fmt.Errorf("enum only supports fields that are either pointers or interfaces, unless they are ignored")
-		}
-		if !field.IsNil() {
-			if _, err := e.w.Write(ULEB128Encode(i)); err != nil {
-				return err
-			}
-			if fieldKind == reflect.Pointer {
-				return e.encode(reflect.Indirect(field))
-			} else {
-				return e.encode(v)
-			}
+		if _, err := e.w.Write(ULEB128Encode(index)); err != nil {
+			return err
 		}
+		return e.encode(field.Elem())
 	}
 
-	return fmt.Errorf("no field is set in the enum")
+	return ErrEmptyEnum
 }
 
 // encodeByteSlice is specialized since bytes those can be simply put into the output.
@@ -252,7 +250,11 @@ func (e *Encoder) encodeStruct(v reflect.Value) error {
 //   - Use tag `optional` to indicate an optional value in rust.
 //     the field must be pointer or interface.
 //   - Use tag `-` to ignore fields.
+//   - Use tag `variant=N` to set the index of an [Enum] variant, and tag `unknown` for its
+//     catch-all [UnknownVariant].
 //   - Unexported fields are ignored.
+//   - Use [U128] and [U256] for move u128 and u256.
+//   - Types annotated with `//bcs:generate` get reflection-free methods from cmd/bcsgen, see [Generated].
 //
 // Note that bcs doesn't have schema, and field names are irrelevant. The fields
 // of struct are serialized in the order that they are defined.
@@ -0,0 +1,96 @@
+package mystenbcs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrUnknownVariant is returned when decoding an [Enum] variant which the enum doesn't declare.
+var ErrUnknownVariant = errors.New("unknown enum variant")
+
+// ErrEmptyEnum is returned when encoding an [Enum] without any variant set.
+var ErrEmptyEnum = errors.New("no field is set in the enum")
+
+// UnknownVariant is the catch-all variant of an [Enum], a `*UnknownVariant` field tagged
+// `bcs:"unknown"`. A variant the enum doesn't declare, e.g. one added by a protocol upgrade,
+// is decoded into it instead of failing, and encoded back as is.
+//
+// BCS doesn't encode the length of a variant, so its Data is the rest of the input. It is only
+// decoded by [Unmarshal] when nothing follows the enum in the value, e.g. the enum is the value
+// itself or its last field, otherwise [ErrUnknownVariant] is returned.
+type UnknownVariant struct {
+	Index int
+	Data  []byte
+}
+
+var unknownVariantType = reflect.TypeFor[*UnknownVariant]()
+
+// enumVariants maps the variant indexes of an [Enum] to its fields.
+type enumVariants struct {
+	// fields are the field of each variant index.
+	fields map[int]int
+	// indexes are the variant index of each field.
+	indexes map[int]int
+	// unknown is the field of the catch-all variant, -1 when there is none.
+	unknown int
+}
+
+var enumVariantsCache sync.Map // map[reflect.Type]*enumVariants
+
+// enumVariantsOf returns the variants of an [Enum] struct.
+//
+// The variant of a field is its `variant=N` tag, or the one following the previous variant, so
+// the variants of an enum without tags are the order of its fields. Unexported and ignored fields
+// are not variants.
+func enumVariantsOf(t reflect.Type) (*enumVariants, error) {
+	if cached, ok := enumVariantsCache.Load(t); ok {
+		return cached.(*enumVariants), nil
+	}
+
+	variants := &enumVariants{fields: make(map[int]int), indexes: make(map[int]int), unknown: -1}
+	next := 0
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, err := parseTagValue(field.Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+		if tag.isIgnored() {
+			continue
+		}
+		kind := field.Type.Kind()
+		if kind != reflect.Pointer && kind != reflect.Interface {
+			return nil, fmt.Errorf("enum only supports fields that are either pointers or interfaces, unless they are ignored")
+		}
+
+		if tag.isUnknown() {
+			if field.Type != unknownVariantType {
+				return nil, fmt.Errorf("unknown variant %s of enum %s must be a *UnknownVariant", field.Name, t)
+			}
+			if variants.unknown >= 0 {
+				return nil, fmt.Errorf("enum %s has more than one unknown variant", t)
+			}
+			variants.unknown = i
+			continue
+		}
+
+		index := next
+		if tag.variant >= 0 {
+			index = tag.variant
+		}
+		if other, ok := variants.fields[index]; ok {
+			return nil, fmt.Errorf("fields %s and %s of enum %s are both variant %d", t.Field(other).Name, field.Name, t, index)
+		}
+		variants.fields[index] = i
+		variants.indexes[i] = index
+		next = index + 1
+	}
+
+	cached, _ := enumVariantsCache.LoadOrStore(t, variants)
+	return cached.(*enumVariants), nil
+}
@@ -0,0 +1,152 @@
+package mystenbcs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type ownerV1 struct {
+	AddressOwner *[2]byte
+	ObjectOwner  *[2]byte
+	Shared       *uint64
+	Immutable    any
+}
+
+func (ownerV1) IsBcsEnum() {}
+
+// ownerV2 has a gap at variant 2 and the catch-all variant.
+type ownerV2 struct {
+	AddressOwner *[2]byte
+	ObjectOwner  *[2]byte
+	Immutable    any             `bcs:"variant=3"`
+	Consensus    *uint16         `bcs:"variant=5"`
+	Unknown      *UnknownVariant `bcs:"unknown"`
+}
+
+func (ownerV2) IsBcsEnum() {}
+
+type ownedObject struct {
+	Version uint64
+	Owner   ownerV2
+}
+
+type ownedObjectWithDigest struct {
+	Owner  ownerV2
+	Digest [2]byte
+}
+
+func TestEnumVariants(t *testing.T) {
+	shared := uint64(7)
+	data, err := Marshal(ownerV1{Shared: &shared})
+	require.NoError(t, err)
+	require.Equal(t, []byte{2, 7, 0, 0, 0, 0, 0, 0, 0}, data)
+
+	data, err = Marshal(ownerV1{Immutable: struct{}{}})
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, data)
+
+	tests := []struct {
+		name  string
+		value ownerV2
+		bcs   []byte
+	}{
+		{name: "address", value: ownerV2{AddressOwner: &[2]byte{1, 2}}, bcs: []byte{0, 1, 2}},
+		{name: "object", value: ownerV2{ObjectOwner: &[2]byte{3, 4}}, bcs: []byte{1, 3, 4}},
+		{name: "immutable", value: ownerV2{Immutable: struct{}{}}, bcs: []byte{3}},
+		{name: "consensus", value: ownerV2{Consensus: new(uint16)}, bcs: []byte{5, 0, 0}},
+		{name: "unknown", value: ownerV2{Unknown: &UnknownVariant{Index: 2, Data: []byte{7, 0, 0, 0, 0, 0, 0, 0}}}, bcs: []byte{2, 7, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.bcs, data)
+
+			var decoded ownerV2
+			n, err := Unmarshal(data, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, len(data), n)
+			require.Equal(t, tt.value, decoded)
+		})
+	}
+}
+
+func TestEnumUnknownVariant(t *testing.T) {
+	// a v1 owner unknown to v2 is kept when it is the last value
+	shared := uint64(7)
+	data, err := Marshal(struct {
+		Version uint64
+		Owner   ownerV1
+	}{Version: 1, Owner: ownerV1{Shared: &shared}})
+	require.NoError(t, err)
+
+	var object ownedObject
+	n, err := Unmarshal(data, &object)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, uint64(1), object.Version)
+	require.Equal(t, &UnknownVariant{Index: 2, Data: []byte{7, 0, 0, 0, 0, 0, 0, 0}}, object.Owner.Unknown)
+
+	encoded, err := Marshal(object)
+	require.NoError(t, err)
+	require.Equal(t, data, encoded)
+
+	// its length is unknown when other values follow it
+	data, err = Marshal(struct {
+		Owner  ownerV1
+		Digest [2]byte
+	}{Owner: ownerV1{Shared: &shared}})
+	require.NoError(t, err)
+	_, err = Unmarshal(data, &ownedObjectWithDigest{})
+	require.True(t, errors.Is(err, ErrUnknownVariant))
+
+	var owners []ownerV2
+	_, err = Unmarshal([]byte{2, 2, 0, 0}, &owners)
+	require.True(t, errors.Is(err, ErrUnknownVariant))
+	_, err = Unmarshal([]byte{2, 0, 0, 0, 2, 0}, &owners)
+	require.NoError(t, err)
+	require.Equal(t, []ownerV2{{AddressOwner: &[2]byte{}}, {Unknown: &UnknownVariant{Index: 2, Data: []byte{0}}}}, owners)
+
+	// a Decoder may read more values
+	_, err = NewDecoder(bytes.NewReader([]byte{2, 0})).Decode(&ownerV2{})
+	require.True(t, errors.Is(err, ErrUnknownVariant))
+
+	// without a catch-all variant
+	_, err = Unmarshal([]byte{9}, &ownerV1{})
+	require.True(t, errors.Is(err, ErrUnknownVariant))
+}
+
+type duplicateVariants struct {
+	A *uint8
+	B *uint8 `bcs:"variant=0"`
+}
+
+func (duplicateVariants) IsBcsEnum() {}
+
+type invalidUnknownVariant struct {
+	A       *uint8
+	Unknown *[]byte `bcs:"unknown"`
+}
+
+func (invalidUnknownVariant) IsBcsEnum() {}
+
+type invalidVariantTag struct {
+	A *uint8 `bcs:"variant=a"`
+}
+
+func (invalidVariantTag) IsBcsEnum() {}
+
+func TestEnumInvalidVariants(t *testing.T) {
+	one := uint8(1)
+	_, err := Marshal(duplicateVariants{A: &one})
+	require.Error(t, err)
+	_, err = Unmarshal([]byte{0, 1}, &duplicateVariants{})
+	require.Error(t, err)
+	_, err = Marshal(invalidUnknownVariant{A: &one})
+	require.Error(t, err)
+	_, err = Marshal(invalidVariantTag{A: &one})
+	require.Error(t, err)
+}
@@ -0,0 +1,224 @@
+package mystenbcs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Generated is implemented by the types with BCS methods generated by cmd/bcsgen. Their
+// AppendBCS and DecodeBCS methods are called by the generated methods of the types containing
+// them, so nested values share the buffer and the [Reader].
+//
+// The generated methods encode and decode as the reflective path, [MarshalReflect] and
+// [UnmarshalReflect] ignore them to check it.
+type Generated interface {
+	Marshaler
+	Unmarshaler
+	AppendBCS(b []byte) ([]byte, error)
+	DecodeBCS(r *Reader)
+}
+
+var generatedType = reflect.TypeFor[Generated]()
+
+// isGenerated reports whether the type or its pointer is [Generated].
+func isGenerated(t reflect.Type) bool {
+	return t.Implements(generatedType) || (t.Kind() != reflect.Pointer && reflect.PointerTo(t).Implements(generatedType))
+}
+
+// MarshalReflect is [Marshal] ignoring the generated methods of [Generated] types.
+func MarshalReflect(v any) ([]byte, error) {
+	var b bytes.Buffer
+	e := NewEncoder(&b)
+	e.reflectGenerated = true
+
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// UnmarshalReflect is [UnmarshalWithOptions] ignoring the generated methods of [Generated] types.
+func UnmarshalReflect(data []byte, v any, options *DecodeOptions) (int, error) {
+	d := NewDecoderWithOptions(bytes.NewReader(data), options)
+	d.tail = true
+	d.reflectGenerated = true
+	return d.Decode(v)
+}
+
+// AppendULEB128 appends the ULEB128 encoding of the integer to b.
+func AppendULEB128(b []byte, n int) []byte {
+	return binary.AppendUvarint(b, uint64(n))
+}
+
+// Append appends the encoding of v to b, the generated methods encode the values of interfaces
+// with it.
+func Append(b []byte, v any) ([]byte, error) {
+	buffer := bytes.NewBuffer(b)
+	if err := NewEncoder(buffer).Encode(v); err != nil {
+		return b, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Reader reads the values of the generated DecodeBCS methods. The first error is kept and stops
+// the reading, the following reads return zero values.
+type Reader struct {
+	reader *limitReader
+	n      int
+	err    error
+	buffer [8]byte
+}
+
+// NewReader creates a [Reader] from an [io.Reader]. The Reader of an UnmarshalBCS method called
+// by a [Decoder] shares its limits.
+func NewReader(r io.Reader) *Reader {
+	reader, _ := newLimitReader(r, nil)
+	return &Reader{reader: reader}
+}
+
+// N returns the number of bytes read.
+func (r *Reader) N() int {
+	return r.n
+}
+
+// Err returns the first error.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// FailUnknownVariant records an [ErrUnknownVariant] of the enum.
+func (r *Reader) FailUnknownVariant(index int, enum string) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%w %d of %s", ErrUnknownVariant, index, enum)
+	}
+}
+
+// ReadFull reads len(b) bytes, a byte array.
+func (r *Reader) ReadFull(b []byte) {
+	if r.err != nil {
+		return
+	}
+	k, err := io.ReadFull(r.reader, b)
+	r.n += k
+	r.err = err
+}
+
+func (r *Reader) read(size int) []byte {
+	b := r.buffer[:size]
+	if r.err != nil {
+		clear(b)
+		return b
+	}
+	r.ReadFull(b)
+	return b
+}
+
+// ReadBool reads a bool, any byte but 0 is true.
+func (r *Reader) ReadBool() bool {
+	return r.read(1)[0] != 0
+}
+
+func (r *Reader) ReadUint8() uint8 {
+	return r.read(1)[0]
+}
+
+func (r *Reader) ReadUint16() uint16 {
+	return binary.LittleEndian.Uint16(r.read(2))
+}
+
+func (r *Reader) ReadUint32() uint32 {
+	return binary.LittleEndian.Uint32(r.read(4))
+}
+
+func (r *Reader) ReadUint64() uint64 {
+	return binary.LittleEndian.Uint64(r.read(8))
+}
+
+// ReadVariant reads the index of an enum variant.
+func (r *Reader) ReadVariant() int {
+	if r.err != nil {
+		return 0
+	}
+	index, k, err := ULEB128Decode[int](r.reader)
+	r.n += k
+	r.err = err
+	return index
+}
+
+// ReadLen reads the length of a vector, within the limits of the decoding. A vector of elements
+// is allocated for at most [MaxPrealloc] of them before they are decoded.
+func (r *Reader) ReadLen() int {
+	if r.err != nil {
+		return 0
+	}
+	length, k, err := r.reader.readLength()
+	r.n += k
+	r.err = err
+	return length
+}
+
+// ReadBytes reads a vector of bytes, nil if it is empty.
+func (r *Reader) ReadBytes() []byte {
+	size := r.ReadLen()
+	if size == 0 || r.err != nil {
+		return nil
+	}
+	b, k, err := readBytes(r.reader, size)
+	r.n += k
+	r.err = err
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// Enter adds a level of nesting for a struct, an enum, or a vector or an array of values other
+// than bytes. Each Enter is followed by a Leave, even when the reading fails.
+func (r *Reader) Enter() {
+	if r.err == nil {
+		r.err = r.reader.enter()
+	}
+	if r.err != nil {
+		// the level Leave removes
+		r.reader.depth++
+	}
+}
+
+// Leave removes the level of nesting added by Enter.
+func (r *Reader) Leave() {
+	r.reader.leave()
+}
+
+// ReadString reads a string.
+func (r *Reader) ReadString() string {
+	return string(r.ReadBytes())
+}
+
+// Unmarshal decodes an [Unmarshaler] which isn't [Generated].
+func (r *Reader) Unmarshal(v Unmarshaler) {
+	if r.err != nil {
+		return
+	}
+	if r.err = r.reader.enter(); r.err != nil {
+		return
+	}
+	k, err := v.UnmarshalBCS(r.reader)
+	r.reader.leave()
+	r.n += k
+	r.err = err
+}
+
+// Decode decodes v with the reflective [Decoder], the generated methods decode the values of
+// interfaces with it.
+func (r *Reader) Decode(v any) {
+	if r.err != nil {
+		return
+	}
+	k, err := NewDecoder(r.reader).Decode(v)
+	r.n += k
+	r.err = err
+}
@@ -0,0 +1,163 @@
+package mystenbcs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	// ErrMaxBytes is returned when the input is longer than [DecodeOptions.MaxBytes].
+	ErrMaxBytes = errors.New("input exceeds the maximum number of bytes")
+	// ErrMaxSequenceLength is returned when the length of a vector or a string is larger than
+	// [DecodeOptions.MaxSequenceLength], or than the BCS limit of 2^31-1.
+	ErrMaxSequenceLength = errors.New("sequence exceeds the maximum length")
+	// ErrMaxDepth is returned when the values are nested deeper than [DecodeOptions.MaxDepth].
+	ErrMaxDepth = errors.New("value exceeds the maximum nesting depth")
+	// ErrTrailingBytes is returned when input remains after the value and
+	// [DecodeOptions.RejectTrailingBytes] is set.
+	ErrTrailingBytes = errors.New("trailing bytes after the value")
+)
+
+// MaxSequenceLength is the longest vector or string of BCS.
+const MaxSequenceLength = math.MaxInt32
+
+// MaxPrealloc is the most elements allocated for a vector before they are read, a longer vector
+// grows as its elements are decoded. The length comes from the input, it can't size an allocation.
+const MaxPrealloc = 1024
+
+// DecodeOptions limits the resources used to decode untrusted input, the zero value of a field
+// doesn't limit it.
+type DecodeOptions struct {
+	// MaxBytes is the most bytes read from the input.
+	MaxBytes int
+	// MaxSequenceLength is the longest vector or string.
+	MaxSequenceLength int
+	// MaxDepth is the deepest nesting of values. Structs, enums, vectors and arrays of values
+	// other than bytes, and the values of [Unmarshaler] types each add a level.
+	MaxDepth int
+	// RejectTrailingBytes fails when input remains after the value. The [Decoder] checks it by
+	// reading one more byte.
+	RejectTrailingBytes bool
+}
+
+// DecodeError is a decoding error at the byte offset of the input.
+type DecodeError struct {
+	Offset int
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v at byte %d", e.Err, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// limitReader counts the bytes of the input and checks the limits of the decoding. It is shared by
+// the [Decoder], the [Reader] of the generated methods and the decoders of [Unmarshaler] types
+// reading from it, so the offset and the limits cover the whole value.
+type limitReader struct {
+	reader  io.Reader
+	options DecodeOptions
+	offset  int
+	depth   int
+}
+
+// newLimitReader wraps the input, it returns the limitReader of a decoder calling an
+// [Unmarshaler] with it, whose limits apply instead of the options.
+func newLimitReader(r io.Reader, options *DecodeOptions) (*limitReader, bool) {
+	if l, ok := r.(*limitReader); ok {
+		return l, true
+	}
+	l := &limitReader{reader: r}
+	if options != nil {
+		l.options = *options
+	}
+	return l, false
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if max := l.options.MaxBytes; max > 0 && l.offset+len(p) > max {
+		if l.offset >= max && len(p) > 0 {
+			return 0, l.fail(ErrMaxBytes)
+		}
+		p = p[:max-l.offset]
+	}
+	n, err := l.reader.Read(p)
+	l.offset += n
+	return n, err
+}
+
+func (l *limitReader) fail(err error) error {
+	return &DecodeError{Offset: l.offset, Err: err}
+}
+
+// wrap adds the offset to an error which doesn't have one.
+func (l *limitReader) wrap(err error) error {
+	var decodeError *DecodeError
+	if err == nil || errors.As(err, &decodeError) {
+		return err
+	}
+	return l.fail(err)
+}
+
+// readLength reads the length of a vector or a string.
+func (l *limitReader) readLength() (int, int, error) {
+	offset := l.offset
+	size, n, err := ULEB128Decode[uint64](l)
+	if err != nil {
+		return 0, n, err
+	}
+	max := MaxSequenceLength
+	if l.options.MaxSequenceLength > 0 {
+		max = l.options.MaxSequenceLength
+	}
+	if size > uint64(max) {
+		return 0, n, &DecodeError{Offset: offset, Err: fmt.Errorf("%w %d: %d", ErrMaxSequenceLength, max, size)}
+	}
+	return int(size), n, nil
+}
+
+// enter adds a level of nesting, the caller leaves it unless it fails.
+func (l *limitReader) enter() error {
+	if l.options.MaxDepth > 0 && l.depth >= l.options.MaxDepth {
+		return l.fail(fmt.Errorf("%w %d", ErrMaxDepth, l.options.MaxDepth))
+	}
+	l.depth++
+	return nil
+}
+
+func (l *limitReader) leave() {
+	l.depth--
+}
+
+// checkEOF fails if a byte remains.
+func (l *limitReader) checkEOF() error {
+	offset := l.offset
+	var b [1]byte
+	if n, _ := l.Read(b[:]); n > 0 {
+		return &DecodeError{Offset: offset, Err: ErrTrailingBytes}
+	}
+	return nil
+}
+
+// readBytes reads size bytes. The buffer of a long vector grows as its bytes arrive, a length
+// larger than the input doesn't allocate it.
+func readBytes(r io.Reader, size int) ([]byte, int, error) {
+	if size <= MaxPrealloc {
+		b := make([]byte, size)
+		n, err := io.ReadFull(r, b)
+		return b, n, err
+	}
+	var buffer bytes.Buffer
+	buffer.Grow(MaxPrealloc)
+	n, err := io.CopyN(&buffer, r, int64(size))
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return buffer.Bytes(), int(n), err
+}
@@ -0,0 +1,121 @@
+package mystenbcs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type limitsValue struct {
+	Name   string
+	Data   []byte
+	Values []uint16
+}
+
+type tree struct {
+	Children []tree
+}
+
+// wrappedTree decodes its tree with a decoder of the reader it is given.
+type wrappedTree struct {
+	Tree tree
+}
+
+func (w *wrappedTree) UnmarshalBCS(r io.Reader) (int, error) {
+	return NewDecoder(r).Decode(&w.Tree)
+}
+
+func TestDecodeLimits(t *testing.T) {
+	value, err := Marshal(limitsValue{Name: "abc", Data: []byte{1, 2}, Values: []uint16{1, 2, 3, 4}})
+	require.NoError(t, err)
+	// four structs and their vectors, an empty vector is a level
+	deep, err := Marshal(tree{Children: []tree{{Children: []tree{{Children: []tree{{}}}}}}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		target  any
+		options *DecodeOptions
+		wantErr error
+		offset  int
+	}{
+		{name: "no limits", data: value, target: &limitsValue{}},
+		{name: "max bytes", data: value, target: &limitsValue{}, options: &DecodeOptions{MaxBytes: 8}, wantErr: ErrMaxBytes, offset: 8},
+		{name: "max bytes of the value", data: value, target: &limitsValue{}, options: &DecodeOptions{MaxBytes: len(value)}},
+		{name: "max string length", data: value, target: &limitsValue{}, options: &DecodeOptions{MaxSequenceLength: 2}, wantErr: ErrMaxSequenceLength, offset: 0},
+		{name: "max vector length", data: value, target: &limitsValue{}, options: &DecodeOptions{MaxSequenceLength: 3}, wantErr: ErrMaxSequenceLength, offset: 7},
+		{name: "bcs max sequence length", data: []byte{0x80, 0x80, 0x80, 0x80, 0x08}, target: &limitsValue{}, wantErr: ErrMaxSequenceLength, offset: 0},
+		{name: "max depth", data: deep, target: &tree{}, options: &DecodeOptions{MaxDepth: 7}, wantErr: ErrMaxDepth, offset: 4},
+		{name: "max depth of the value", data: deep, target: &tree{}, options: &DecodeOptions{MaxDepth: 8}},
+		{name: "max depth of an unmarshaler", data: deep, target: &wrappedTree{}, options: &DecodeOptions{MaxDepth: 8}, wantErr: ErrMaxDepth, offset: 4},
+		{name: "trailing bytes", data: append(value, 0), target: &limitsValue{}, options: &DecodeOptions{RejectTrailingBytes: true}, wantErr: ErrTrailingBytes, offset: len(value)},
+		{name: "truncated", data: value[:len(value)-1], target: &limitsValue{}, wantErr: io.ErrUnexpectedEOF, offset: len(value) - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := UnmarshalWithOptions(tt.data, tt.target, tt.options)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, len(tt.data), n)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			var decodeError *DecodeError
+			require.True(t, errors.As(err, &decodeError))
+			require.Equal(t, tt.offset, decodeError.Offset)
+		})
+	}
+}
+
+func TestDecodeUntrustedLength(t *testing.T) {
+	// the lengths of 2^31-1 elements are not followed by them
+	tests := []struct {
+		name   string
+		target any
+	}{
+		{name: "bytes", target: &limitsValue{}},
+		{name: "vector", target: &[]uint64{}},
+		{name: "vector of vectors", target: &[][]byte{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+			_, err := Unmarshal([]byte{0xff, 0xff, 0xff, 0xff, 0x07}, tt.target)
+			runtime.ReadMemStats(&after)
+
+			var decodeError *DecodeError
+			require.True(t, errors.As(err, &decodeError))
+			require.Equal(t, 5, decodeError.Offset)
+			require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+		})
+	}
+}
+
+func TestDecoderMaxBytes(t *testing.T) {
+	var input bytes.Buffer
+	for i := uint16(0); i < 3; i++ {
+		data, err := Marshal(limitsValue{Values: []uint16{i}})
+		require.NoError(t, err)
+		input.Write(data)
+	}
+
+	// the limit covers the values decoded from the input
+	d := NewDecoderWithOptions(&input, &DecodeOptions{MaxBytes: 10})
+	for i := 0; i < 2; i++ {
+		var value limitsValue
+		_, err := d.Decode(&value)
+		require.NoError(t, err)
+		require.Equal(t, []uint16{uint16(i)}, value.Values)
+	}
+	var value limitsValue
+	_, err := d.Decode(&value)
+	require.ErrorIs(t, err, ErrMaxBytes)
+}
@@ -3,33 +3,49 @@ package mystenbcs
 // https://github.com/fardream/go-bcs/blob/main/bcs/tag.go
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 const tagName = "bcs"
 
-type tagValue int64
+type tagValue struct {
+	flags int64
+	// variant is the enum variant index set by `variant=N`, -1 when not set.
+	variant int
+}
 
 const (
-	tagValue_Optional tagValue = 1 << iota // optional
-	tagValue_Ignore                        // -
+	tagValue_Optional int64 = 1 << iota // optional
+	tagValue_Ignore                     // -
+	tagValue_Unknown                    // unknown
 )
 
+const variantTagPrefix = "variant="
+
 func parseTagValue(tag string) (tagValue, error) {
-	var r tagValue
+	r := tagValue{variant: -1}
 	tagSegs := strings.SplitSeq(tag, ",")
 	for seg := range tagSegs {
 		seg := strings.TrimSpace(seg)
 		if seg == "" {
 			continue
 		}
-		switch seg {
-		case "optional":
-			r |= tagValue_Optional
-		case "-":
-			return tagValue_Ignore, nil
+		switch {
+		case seg == "optional":
+			r.flags |= tagValue_Optional
+		case seg == "unknown":
+			r.flags |= tagValue_Unknown
+		case seg == "-":
+			return tagValue{flags: tagValue_Ignore, variant: -1}, nil
+		case strings.HasPrefix(seg, variantTagPrefix):
+			variant, err := strconv.Atoi(strings.TrimPrefix(seg, variantTagPrefix))
+			if err != nil || variant < 0 {
+				return tagValue{}, fmt.Errorf("invalid variant index: %s in %s", seg, tag)
+			}
+			r.variant = variant
 		default:
-			return 0, fmt.Errorf("unknown tag: %s in %s", seg, tag)
+			return tagValue{}, fmt.Errorf("unknown tag: %s in %s", seg, tag)
 		}
 	}
 
@@ -37,9 +53,13 @@ func parseTagValue(tag string) (tagValue, error) {
 }
 
 func (t tagValue) isOptional() bool {
-	return t&tagValue_Optional != 0
+	return t.flags&tagValue_Optional != 0
 }
 
 func (t tagValue) isIgnored() bool {
-	return t&tagValue_Ignore != 0
+	return t.flags&tagValue_Ignore != 0
+}
+
+func (t tagValue) isUnknown() bool {
+	return t.flags&tagValue_Unknown != 0
 }
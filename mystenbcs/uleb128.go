@@ -43,6 +43,9 @@ func ULEB128Decode[T ULEB128SupportedTypes](r io.Reader) (T, int, error) {
 	for n < 10 {
 		i, err := r.Read(buf)
 		if i == 0 {
+			if err != nil && err != io.EOF {
+				return 0, n, err
+			}
 			return 0, n, fmt.Errorf("zero read in. possible EOF")
 		}
 		if err != nil {
@@ -0,0 +1,129 @@
+package passkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+)
+
+// AuthenticatorAssertion is the response of navigator.credentials.get.
+type AuthenticatorAssertion struct {
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	// Signature is the ASN.1 DER encoded ECDSA signature.
+	Signature []byte
+}
+
+// Authenticator produces WebAuthn assertions with a P-256 credential, e.g. a platform
+// authenticator behind a browser or a secure enclave.
+type Authenticator interface {
+	// PublicKey returns the 33 bytes compressed public key of the credential.
+	PublicKey() []byte
+	// GetAssertion signs the challenge.
+	GetAssertion(challenge []byte) (*AuthenticatorAssertion, error)
+}
+
+// NewPasskeyAuthenticator builds the passkey signature from an assertion over the intent message digest.
+// The DER signature is converted to its 64 bytes form with a normalized s.
+func NewPasskeyAuthenticator(publicKey []byte, assertion *AuthenticatorAssertion) (*PasskeyAuthenticator, error) {
+	if len(publicKey) != secp256r1.PublicKeySize {
+		return nil, errors.New("invalid passkey public key")
+	}
+
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(assertion.Signature, &sig); err != nil {
+		return nil, errors.New("invalid passkey signature encoding")
+	}
+	n := elliptic.P256().Params().N
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(n) >= 0 || sig.S.Cmp(n) >= 0 {
+		return nil, errors.New("invalid passkey signature")
+	}
+	if sig.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sig.S.Sub(n, sig.S)
+	}
+
+	userSignature := make([]byte, 1+secp256r1.SignatureSize, 1+secp256r1.SignatureSize+secp256r1.PublicKeySize)
+	userSignature[0] = scheme.SignatureSchemeToFlag[scheme.Secp256r1]
+	sig.R.FillBytes(userSignature[1:33])
+	sig.S.FillBytes(userSignature[33:65])
+
+	return &PasskeyAuthenticator{
+		AuthenticatorData: assertion.AuthenticatorData,
+		ClientDataJson:    string(assertion.ClientDataJSON),
+		UserSignature:     append(userSignature, publicKey...),
+	}, nil
+}
+
+// SoftwareAuthenticator is an in memory authenticator, meant for tests and tooling.
+type SoftwareAuthenticator struct {
+	priKey    *ecdsa.PrivateKey
+	origin    string
+	rpId      string
+	mu        sync.Mutex
+	signCount uint32
+}
+
+// NewSoftwareAuthenticator creates an authenticator with a random credential for the relying party.
+func NewSoftwareAuthenticator(origin string, rpId string) (*SoftwareAuthenticator, error) {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SoftwareAuthenticator{
+		priKey: priKey,
+		origin: origin,
+		rpId:   rpId,
+	}, nil
+}
+
+func (a *SoftwareAuthenticator) PublicKey() []byte {
+	return elliptic.MarshalCompressed(elliptic.P256(), a.priKey.X, a.priKey.Y)
+}
+
+func (a *SoftwareAuthenticator) GetAssertion(challenge []byte) (*AuthenticatorAssertion, error) {
+	clientDataJSON, err := json.Marshal(ClientDataJSON{
+		Type:      "webauthn.get",
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    a.origin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	a.signCount++
+	signCount := a.signCount
+	a.mu.Unlock()
+
+	// rpIdHash || flags (user present, user verified) || signCount
+	rpIdHash := sha256.Sum256([]byte(a.rpId))
+	authenticatorData := append(rpIdHash[:], 0x05)
+	authenticatorData = binary.BigEndian.AppendUint32(authenticatorData, signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	payload := sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.priKey, payload[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticatorAssertion{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         signature,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code generated by bcsgen. DO NOT EDIT.
+
+package passkey
+
+import (
+	"io"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func (v PasskeyAuthenticator) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *PasskeyAuthenticator) AppendBCS(b []byte) ([]byte, error) {
+	b = mystenbcs.AppendULEB128(b, len(v.AuthenticatorData))
+	b = append(b, v.AuthenticatorData...)
+	b = mystenbcs.AppendULEB128(b, len(v.ClientDataJson))
+	b = append(b, v.ClientDataJson...)
+	b = mystenbcs.AppendULEB128(b, len(v.UserSignature))
+	b = append(b, v.UserSignature...)
+	return b, nil
+}
+
+func (v *PasskeyAuthenticator) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *PasskeyAuthenticator) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if data1 := r.ReadBytes(); data1 != nil {
+		v.AuthenticatorData = data1
+	}
+	v.ClientDataJson = r.ReadString()
+	if data2 := r.ReadBytes(); data2 != nil {
+		v.UserSignature = data2
+	}
+	r.Leave()
+}
@@ -0,0 +1,22 @@
+package passkey
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcstest"
+)
+
+func TestGeneratedBcs(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(testing.TB, *rand.Rand, int)
+	}{
+		{name: "PasskeyAuthenticator", check: bcstest.Check[PasskeyAuthenticator]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, rand.New(rand.NewSource(1)), 500)
+		})
+	}
+}
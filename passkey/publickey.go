@@ -0,0 +1,110 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/machinebox/graphql"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// ClientDataJSON is the subset of the WebAuthn client data checked by the verifier.
+type ClientDataJSON struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin,omitempty"`
+}
+
+type PasskeyPublicKey struct {
+	data []byte
+}
+
+// NewPasskeyPublicKey wraps a 33 bytes compressed secp256r1 public key.
+func NewPasskeyPublicKey(data []byte) *PasskeyPublicKey {
+	return &PasskeyPublicKey{
+		data: data,
+	}
+}
+
+// ToRawBytes returns the 33 bytes compressed public key.
+func (p *PasskeyPublicKey) ToRawBytes() []byte {
+	return p.data
+}
+
+func (p *PasskeyPublicKey) Scheme() scheme.SignatureScheme {
+	return scheme.Passkey
+}
+
+func (p *PasskeyPublicKey) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.Passkey]
+}
+
+func (p *PasskeyPublicKey) ToSuiBytes() []byte {
+	return models.ToSuiBytes(p.Flag(), p.data)
+}
+
+func (p *PasskeyPublicKey) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(p.ToSuiBytes())
+}
+
+func (p *PasskeyPublicKey) ToSuiAddress() string {
+	return models.PublicKeyToSuiAddress(p.Flag(), p.data)
+}
+
+// VerifyPersonalMessage verifies the serialized passkey signature over the personal message.
+func (p *PasskeyPublicKey) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
+	intentMessage := models.NewPersonalMessageIntentMessage(message)
+	return p.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+}
+
+// VerifyTransaction verifies the serialized passkey signature over the transaction data bytes.
+func (p *PasskeyPublicKey) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	return p.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+}
+
+// VerifyWithIntent verifies the serialized passkey signature over the blake2b digest of the intent message.
+func (p *PasskeyPublicKey) VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	digest := blake2b.Sum256(models.NewMessageWithIntent(bytes, scope))
+	return p.Verify(digest[:], signature)
+}
+
+// Verify verifies the serialized passkey signature, the WebAuthn challenge must be the message.
+func (p *PasskeyPublicKey) Verify(message []byte, signature []byte) (bool, error) {
+	parsed, err := ParseSerializedPasskeySignature(signature)
+	if err != nil {
+		return false, err
+	}
+
+	var clientData ClientDataJSON
+	if err := json.Unmarshal([]byte(parsed.ClientDataJson), &clientData); err != nil {
+		return false, fmt.Errorf("failed to parse client data json: %v", err)
+	}
+	if clientData.Type != "webauthn.get" {
+		return false, nil
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(clientData.Challenge, "="))
+	if err != nil {
+		return false, fmt.Errorf("failed to decode challenge: %v", err)
+	}
+	if !bytes.Equal(challenge, message) {
+		return false, nil
+	}
+	if !bytes.Equal(parsed.PubKey, p.data) {
+		return false, nil
+	}
+
+	clientDataHash := sha256.Sum256([]byte(parsed.ClientDataJson))
+	payload := append(append([]byte{}, parsed.AuthenticatorData...), clientDataHash[:]...)
+
+	return secp256r1.NewSecp256r1PublicKey(p.data).Verify(payload, parsed.UserSignature[1:1+secp256r1.SignatureSize])
+}
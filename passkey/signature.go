@@ -0,0 +1,89 @@
+package passkey
+
+//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// PasskeyAuthenticator is the bcs layout of a passkey signature, see
+// https://github.com/MystenLabs/sui/blob/main/crates/sui-types/src/passkey_authenticator.rs
+//
+//bcs:generate
+type PasskeyAuthenticator struct {
+	AuthenticatorData []byte
+	ClientDataJson    string
+	// UserSignature is the serialized secp256r1 signature `flag || signature || pubkey`.
+	UserSignature []byte
+}
+
+// maxPasskeySignatureSize bounds the bcs of a passkey authenticator, mostly its client data JSON.
+const maxPasskeySignatureSize = 8 * 1024
+
+type ParsedPasskeySignature struct {
+	SerializedSignature string
+	SignatureScheme     scheme.SignatureScheme
+	// Signature is the full serialized signature, including the flag.
+	Signature         []byte
+	AuthenticatorData []byte
+	ClientDataJson    string
+	UserSignature     []byte
+	PubKey            []byte
+}
+
+// ParseSerializedPasskeySignature parses a serialized passkey signature `0x06 || bcs(PasskeyAuthenticator)`.
+func ParseSerializedPasskeySignature(signature []byte) (*ParsedPasskeySignature, error) {
+	if len(signature) == 0 || signature[0] != scheme.SignatureSchemeToFlag[scheme.Passkey] {
+		return nil, errors.New("invalid signature scheme")
+	}
+
+	var authenticator PasskeyAuthenticator
+	_, err := mystenbcs.UnmarshalWithOptions(signature[1:], &authenticator, &mystenbcs.DecodeOptions{
+		MaxBytes:            maxPasskeySignatureSize,
+		RejectTrailingBytes: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse passkey authenticator: %v", err)
+	}
+
+	userSignature := authenticator.UserSignature
+	if len(userSignature) != 1+secp256r1.SignatureSize+secp256r1.PublicKeySize ||
+		userSignature[0] != scheme.SignatureSchemeToFlag[scheme.Secp256r1] {
+		return nil, errors.New("invalid passkey user signature")
+	}
+
+	return &ParsedPasskeySignature{
+		SerializedSignature: mystenbcs.ToBase64(signature),
+		SignatureScheme:     scheme.Passkey,
+		Signature:           signature,
+		AuthenticatorData:   authenticator.AuthenticatorData,
+		ClientDataJson:      authenticator.ClientDataJson,
+		UserSignature:       userSignature,
+		PubKey:              userSignature[1+secp256r1.SignatureSize:],
+	}, nil
+}
+
+// SerializePasskeySignature serializes the passkey authenticator to `0x06 || bcs(PasskeyAuthenticator)` in base64.
+func SerializePasskeySignature(authenticator *PasskeyAuthenticator) (string, error) {
+	serialized, err := MarshalPasskeySignature(authenticator)
+	if err != nil {
+		return "", err
+	}
+
+	return mystenbcs.ToBase64(serialized), nil
+}
+
+// MarshalPasskeySignature serializes the passkey authenticator to `0x06 || bcs(PasskeyAuthenticator)`.
+func MarshalPasskeySignature(authenticator *PasskeyAuthenticator) ([]byte, error) {
+	bcsBytes, err := mystenbcs.Marshal(authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{scheme.SignatureSchemeToFlag[scheme.Passkey]}, bcsBytes...), nil
+}
@@ -0,0 +1,44 @@
+package passkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func TestSerializePasskeySignature(t *testing.T) {
+	authenticator, err := NewSoftwareAuthenticator("https://www.sui.io", "www.sui.io")
+	require.NoError(t, err)
+	challenge := make([]byte, 32)
+	assertion, err := authenticator.GetAssertion(challenge)
+	require.NoError(t, err)
+
+	passkeyAuthenticator, err := NewPasskeyAuthenticator(authenticator.PublicKey(), assertion)
+	require.NoError(t, err)
+	serialized, err := SerializePasskeySignature(passkeyAuthenticator)
+	require.NoError(t, err)
+
+	signature, err := mystenbcs.FromBase64(serialized)
+	require.NoError(t, err)
+	parsed, err := ParseSerializedPasskeySignature(signature)
+	require.NoError(t, err)
+	require.Equal(t, assertion.AuthenticatorData, parsed.AuthenticatorData)
+	require.Equal(t, string(assertion.ClientDataJSON), parsed.ClientDataJson)
+	require.Equal(t, authenticator.PublicKey(), parsed.PubKey)
+
+	publicKey := NewPasskeyPublicKey(authenticator.PublicKey())
+	pass, err := publicKey.Verify(challenge, signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	pass, err = publicKey.Verify(make([]byte, 31), signature)
+	require.NoError(t, err)
+	require.False(t, pass)
+
+	_, err = ParseSerializedPasskeySignature(append([]byte{0x02}, signature[1:]...))
+	require.Error(t, err)
+	_, err = ParseSerializedPasskeySignature(append(signature, 0))
+	require.ErrorContains(t, err, mystenbcs.ErrTrailingBytes.Error())
+}
@@ -27,17 +27,17 @@ func GetSharedObjectRef(ctx context.Context, client ObjectReader, objectId strin
 	if err != nil {
 		return nil, err
 	}
-	if owner, ok := rsp.Data.Owner.(map[string]any); ok {
-		if value, exists := owner["Shared"]; exists {
-			rv := value.(map[string]interface{})["initial_shared_version"].(float64)
-			obj, _ := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(objectId))
-			sharedObj := transaction.SharedObjectRef{
-				ObjectId:             *obj,
-				InitialSharedVersion: uint64(rv),
-				Mutable:              mutable,
-			}
-			return &sharedObj, nil
+	if initialSharedVersion, ok := rsp.Data.Owner.Shared(); ok {
+		obj, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(objectId))
+		if err != nil {
+			return nil, err
 		}
+		sharedObj := transaction.SharedObjectRef{
+			ObjectId:             *obj,
+			InitialSharedVersion: initialSharedVersion,
+			Mutable:              mutable,
+		}
+		return &sharedObj, nil
 	}
 	return nil, fmt.Errorf("object is not a shared object")
 }
@@ -56,14 +56,16 @@ func GetSuiObjectRef(ctx context.Context, client ObjectReader, objectId string)
 	if err != nil {
 		return nil, err
 	}
-	if owner, ok := rsp.Data.Owner.(map[string]any); ok {
-		if _, exists := owner["Shared"]; !exists {
-			obj, err := transaction.NewSuiObjectRef(models.SuiAddress(objectId), rsp.Data.Version, models.ObjectDigest(rsp.Data.Digest))
-			if err != nil {
-				return nil, err
-			}
-			return obj, nil
+	switch rsp.Data.Owner.Kind() {
+	case models.OwnerKindAddress, models.OwnerKindObject, models.OwnerKindImmutable:
+		obj, err := transaction.NewSuiObjectRef(models.SuiAddress(objectId), rsp.Data.Version, models.ObjectDigest(rsp.Data.Digest))
+		if err != nil {
+			return nil, err
 		}
+		return obj, nil
+	case models.OwnerKindShared:
+		return nil, fmt.Errorf("object is a shared object")
+	default:
+		return nil, fmt.Errorf("object owner %s is not an owned object", rsp.Data.Owner.Kind())
 	}
-	return nil, fmt.Errorf("object is a shared object")
 }
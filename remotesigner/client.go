@@ -0,0 +1,202 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+const defaultTimeout = time.Second * 10
+
+// ClientOptions configures a Client.
+type ClientOptions struct {
+	// AuthToken is sent as a bearer token when set.
+	AuthToken string
+	// HttpClient defaults to a client with a 10 seconds timeout.
+	HttpClient *http.Client
+}
+
+// Client talks to a remote signer server.
+type Client struct {
+	endpoint  string
+	authToken string
+	c         *http.Client
+}
+
+func NewClient(endpoint string, options *ClientOptions) *Client {
+	client := &Client{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		c:        &http.Client{Timeout: defaultTimeout},
+	}
+	if options != nil {
+		client.authToken = options.AuthToken
+		if options.HttpClient != nil {
+			client.c = options.HttpClient
+		}
+	}
+	return client
+}
+
+// Keys lists the keys held by the server.
+func (c *Client) Keys(ctx context.Context) ([]KeyInfo, error) {
+	var rsp KeysResponse
+	if err := c.do(ctx, http.MethodGet, KeysPath, nil, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Keys, nil
+}
+
+// Signer returns a models.Signer signing for address on the server.
+func (c *Client) Signer(ctx context.Context, address string) (*RemoteSigner, error) {
+	keys, err := c.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if normalizeAddress(key.Address) != normalizeAddress(address) {
+			continue
+		}
+		pubKey, err := base64.StdEncoding.DecodeString(key.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key for %s: %v", key.Address, err)
+		}
+		if _, ok := scheme.SignatureSchemeToFlag[scheme.SignatureScheme(key.Scheme)]; !ok {
+			return nil, fmt.Errorf("unsupported signature scheme %s", key.Scheme)
+		}
+		return &RemoteSigner{
+			client:  c,
+			address: key.Address,
+			scheme:  scheme.SignatureScheme(key.Scheme),
+			pubKey:  pubKey,
+		}, nil
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// Sign sends a SignRequest and returns the base64 serialized signature.
+func (c *Client) Sign(ctx context.Context, req *SignRequest) (string, error) {
+	var rsp SignResponse
+	if err := c.do(ctx, http.MethodPost, SignPath, req, &rsp); err != nil {
+		return "", err
+	}
+	return rsp.Signature, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if c.authToken != "" {
+		request.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+
+	rsp, err := c.c.Do(request)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, maxRequestSize))
+	if err != nil {
+		return err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		var errRsp ErrorResponse
+		if json.Unmarshal(data, &errRsp) != nil || errRsp.Error == "" {
+			errRsp.Error = http.StatusText(rsp.StatusCode)
+		}
+		return &ResponseError{StatusCode: rsp.StatusCode, Message: errRsp.Error}
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// RemoteSigner signs for a single key held by a remote signer server.
+type RemoteSigner struct {
+	client  *Client
+	address string
+	scheme  scheme.SignatureScheme
+	pubKey  []byte
+}
+
+var _ models.IntentSigner = (*RemoteSigner)(nil)
+
+func (s *RemoteSigner) GetKeyScheme() scheme.SignatureScheme {
+	return s.scheme
+}
+
+func (s *RemoteSigner) GetPublicKey() []byte {
+	return s.pubKey
+}
+
+func (s *RemoteSigner) ToSuiAddress() string {
+	return s.address
+}
+
+// Sign asks the server to sign a bare digest, which the key policy must allow.
+func (s *RemoteSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	signature, err := s.client.Sign(ctx, &SignRequest{
+		Address: s.address,
+		Digest:  base64.StdEncoding.EncodeToString(digest),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.signatureBytes(signature)
+}
+
+// SignIntentMessage asks the server to sign the intent message, so the key policy can check its scope.
+func (s *RemoteSigner) SignIntentMessage(ctx context.Context, intentMessage []byte) ([]byte, error) {
+	signature, err := s.client.Sign(ctx, &SignRequest{
+		Address:       s.address,
+		IntentMessage: base64.StdEncoding.EncodeToString(intentMessage),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.signatureBytes(signature)
+}
+
+// signatureBytes converts the serialized signature returned by the server into the form
+// returned by models.Signer.Sign, checking it was made by the expected key.
+func (s *RemoteSigner) signatureBytes(signature string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %v", err)
+	}
+	if len(data) == 0 || data[0] != scheme.SignatureSchemeToFlag[s.scheme] {
+		return nil, fmt.Errorf("unexpected signature scheme from remote signer")
+	}
+
+	switch s.scheme {
+	case scheme.MultiSig, scheme.ZkLogin, scheme.Passkey:
+		return data, nil
+	default:
+		if len(data) != 1+64+len(s.pubKey) || !bytes.Equal(data[1+64:], s.pubKey) {
+			return nil, fmt.Errorf("unexpected signer public key from remote signer")
+		}
+		return data[1 : 1+64], nil
+	}
+}
@@ -0,0 +1,78 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+)
+
+// Policy restricts what a key may sign. The zero value allows every intent scope and denies
+// digest signing.
+type Policy struct {
+	// AllowedScopes, when not empty, is the list of intent scopes the key may sign.
+	AllowedScopes []constant.IntentScope
+	// DeniedScopes is the list of intent scopes the key must never sign, it wins over AllowedScopes.
+	DeniedScopes []constant.IntentScope
+	// AllowDigest allows signing bare digests, whose intent scope is unknown.
+	AllowDigest bool
+}
+
+// checkScope returns ErrPolicyDenied when the policy does not allow the intent scope.
+func (p Policy) checkScope(scope constant.IntentScope) error {
+	for _, denied := range p.DeniedScopes {
+		if scope == denied {
+			return ErrPolicyDenied
+		}
+	}
+	if len(p.AllowedScopes) == 0 {
+		return nil
+	}
+	for _, allowed := range p.AllowedScopes {
+		if scope == allowed {
+			return nil
+		}
+	}
+	return ErrPolicyDenied
+}
+
+// AuditEntry records a single sign request handled by the server.
+type AuditEntry struct {
+	Time       time.Time `json:"time"`
+	RemoteAddr string    `json:"remoteAddr"`
+	Address    string    `json:"address"`
+	// Scope is the intent scope, it is nil for digest requests.
+	Scope *constant.IntentScope `json:"scope,omitempty"`
+	// Digest is the base64 blake2b digest that was (or would have been) signed.
+	Digest  string `json:"digest,omitempty"`
+	Allowed bool   `json:"allowed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AuditLogger receives an entry for every sign request, whether it was allowed or not.
+type AuditLogger interface {
+	Log(entry AuditEntry)
+}
+
+// JSONAuditLogger writes audit entries as JSON lines.
+type JSONAuditLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewJSONAuditLogger(w io.Writer) *JSONAuditLogger {
+	return &JSONAuditLogger{w: w}
+}
+
+func (l *JSONAuditLogger) Log(entry AuditEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.w.Write(append(data, '\n'))
+}
@@ -0,0 +1,69 @@
+// Package remotesigner implements a small HTTP/JSON protocol to keep signing keys on an isolated
+// host. The Client implements models.Signer, and the Server wraps local signers with per-key
+// policies and an audit log.
+//
+// The protocol has two endpoints:
+//
+//	GET  /v1/keys  lists the keys held by the server
+//	POST /v1/sign  signs an intent message, or a digest when the key policy allows it
+//
+// Errors are returned with a non 2xx status code and an ErrorResponse body.
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	KeysPath = "/v1/keys"
+	SignPath = "/v1/sign"
+)
+
+var (
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrKeyNotFound   = errors.New("key not found")
+	ErrPolicyDenied  = errors.New("denied by key policy")
+	ErrInvalidIntent = errors.New("invalid intent message")
+)
+
+// KeyInfo describes a key held by the server.
+type KeyInfo struct {
+	Address string `json:"address"`
+	Scheme  string `json:"scheme"`
+	// PublicKey is the base64 raw public key, without the scheme flag.
+	PublicKey string `json:"publicKey"`
+}
+
+type KeysResponse struct {
+	Keys []KeyInfo `json:"keys"`
+}
+
+// SignRequest asks the server to sign for Address. Exactly one of IntentMessage and Digest must be set.
+type SignRequest struct {
+	Address string `json:"address"`
+	// IntentMessage is the base64 intent message `scope || version || app_id || data`.
+	IntentMessage string `json:"intentMessage,omitempty"`
+	// Digest is the base64 blake2b digest of an intent message. The server only signs digests
+	// for keys whose policy allows it, since the intent scope cannot be checked.
+	Digest string `json:"digest,omitempty"`
+}
+
+type SignResponse struct {
+	// Signature is the base64 serialized signature.
+	Signature string `json:"signature"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// ResponseError is returned by the Client when the server answers with an error.
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("remote signer error: status %d: %s", e.StatusCode, e.Message)
+}
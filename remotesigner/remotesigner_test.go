@@ -0,0 +1,135 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/signer"
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	edSigner := signer.NewSigner(make([]byte, 32))
+	k1Signer, err := signer.GenerateSecp256k1Signer()
+	require.NoError(t, err)
+
+	var audit bytes.Buffer
+	server := NewServer(&ServerOptions{AuthToken: "secret", AuditLogger: NewJSONAuditLogger(&audit)})
+	server.AddSigner(edSigner, Policy{DeniedScopes: []constant.IntentScope{constant.PersonalMessageIntentScope}})
+	server.AddSigner(k1Signer, Policy{AllowedScopes: []constant.IntentScope{constant.PersonalMessageIntentScope}, AllowDigest: true})
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	client := NewClient(httpServer.URL, &ClientOptions{AuthToken: "secret"})
+	keys, err := client.Keys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	txBytes := []byte("transaction data")
+	message := []byte("hello")
+
+	tests := []struct {
+		name    string
+		signer  models.Signer
+		sign    func(s models.Signer) (string, error)
+		verify  func(signature string) (string, bool, error)
+		wantErr error
+	}{
+		{
+			name:   "ed25519 transaction",
+			signer: edSigner,
+			sign: func(s models.Signer) (string, error) {
+				return models.SignWithIntent(ctx, s, txBytes, constant.TransactionDataIntentScope)
+			},
+			verify: func(signature string) (string, bool, error) {
+				return verify.VerifyTransactionSignature(txBytes, signature, nil)
+			},
+		},
+		{
+			name:   "ed25519 denied scope",
+			signer: edSigner,
+			sign: func(s models.Signer) (string, error) {
+				return models.SignWithIntent(ctx, s, message, constant.PersonalMessageIntentScope)
+			},
+			wantErr: ErrPolicyDenied,
+		},
+		{
+			name:   "ed25519 digest not allowed",
+			signer: edSigner,
+			sign: func(s models.Signer) (string, error) {
+				return models.SignDigest(ctx, s, make([]byte, 32))
+			},
+			wantErr: ErrPolicyDenied,
+		},
+		{
+			name:   "secp256k1 scope not allowed",
+			signer: k1Signer,
+			sign: func(s models.Signer) (string, error) {
+				return models.SignWithIntent(ctx, s, txBytes, constant.TransactionDataIntentScope)
+			},
+			wantErr: ErrPolicyDenied,
+		},
+		{
+			name:   "secp256k1 digest",
+			signer: k1Signer,
+			sign: func(s models.Signer) (string, error) {
+				digest := blake2b.Sum256(models.NewMessageWithIntent(txBytes, constant.TransactionDataIntentScope))
+				return models.SignDigest(ctx, s, digest[:])
+			},
+			verify: func(signature string) (string, bool, error) {
+				return verify.VerifyTransactionSignature(txBytes, signature, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote, err := client.Signer(ctx, strings.ToUpper(tt.signer.ToSuiAddress()))
+			require.NoError(t, err)
+			require.Equal(t, tt.signer.GetKeyScheme(), remote.GetKeyScheme())
+			require.Equal(t, tt.signer.GetPublicKey(), remote.GetPublicKey())
+
+			signature, err := tt.sign(remote)
+			if tt.wantErr != nil {
+				var rspErr *ResponseError
+				require.True(t, errors.As(err, &rspErr))
+				require.Equal(t, http.StatusForbidden, rspErr.StatusCode)
+				require.Equal(t, tt.wantErr.Error(), rspErr.Message)
+				return
+			}
+			require.NoError(t, err)
+
+			address, pass, err := tt.verify(signature)
+			require.NoError(t, err)
+			require.True(t, pass)
+			require.Equal(t, tt.signer.ToSuiAddress(), address)
+		})
+	}
+
+	entries := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	require.Len(t, entries, len(tests))
+	var entry AuditEntry
+	require.NoError(t, json.Unmarshal([]byte(entries[1]), &entry))
+	require.False(t, entry.Allowed)
+	require.Equal(t, constant.PersonalMessageIntentScope, *entry.Scope)
+	require.Equal(t, edSigner.ToSuiAddress(), entry.Address)
+
+	_, err = NewClient(httpServer.URL, nil).Keys(ctx)
+	var rspErr *ResponseError
+	require.True(t, errors.As(err, &rspErr))
+	require.Equal(t, http.StatusUnauthorized, rspErr.StatusCode)
+
+	_, err = client.Signer(ctx, "0x1")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
@@ -0,0 +1,206 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+// maxRequestSize bounds sign requests, transactions are limited to 128KiB by the protocol.
+const maxRequestSize = 1 << 20
+
+type serverKey struct {
+	signer models.Signer
+	policy Policy
+}
+
+// ServerOptions configures a Server.
+type ServerOptions struct {
+	// AuthToken, when set, must be sent by clients as a bearer token.
+	AuthToken string
+	// AuditLogger receives an entry for every sign request.
+	AuditLogger AuditLogger
+}
+
+// Server is the reference remote signer, it serves the protocol over the signers added to it.
+type Server struct {
+	mu          sync.RWMutex
+	keys        map[string]*serverKey
+	authToken   string
+	auditLogger AuditLogger
+}
+
+var _ http.Handler = (*Server)(nil)
+
+func NewServer(options *ServerOptions) *Server {
+	s := &Server{keys: make(map[string]*serverKey)}
+	if options != nil {
+		s.authToken = options.AuthToken
+		s.auditLogger = options.AuditLogger
+	}
+	return s
+}
+
+// AddSigner serves the signer under its address with the given policy, replacing any signer
+// previously added for the same address.
+func (s *Server) AddSigner(signer models.Signer, policy Policy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[normalizeAddress(signer.ToSuiAddress())] = &serverKey{signer: signer, policy: policy}
+}
+
+// RemoveSigner stops serving the signer of address.
+func (s *Server) RemoveSigner(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, normalizeAddress(address))
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return
+	}
+
+	switch {
+	case r.URL.Path == KeysPath && r.Method == http.MethodGet:
+		s.handleKeys(w)
+	case r.URL.Path == SignPath && r.Method == http.MethodPost:
+		s.handleSign(w, r)
+	case r.URL.Path == KeysPath || r.URL.Path == SignPath:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.authToken == "" {
+		return true
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.authToken)) == 1
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter) {
+	s.mu.RLock()
+	keys := make([]KeyInfo, 0, len(s.keys))
+	for address, key := range s.keys {
+		keys = append(keys, KeyInfo{
+			Address:   address,
+			Scheme:    string(key.signer.GetKeyScheme()),
+			PublicKey: base64.StdEncoding.EncodeToString(key.signer.GetPublicKey()),
+		})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Address < keys[j].Address })
+	writeJSON(w, http.StatusOK, KeysResponse{Keys: keys})
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	var req SignRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %v", err))
+		return
+	}
+
+	entry := AuditEntry{
+		Time:       time.Now().UTC(),
+		RemoteAddr: r.RemoteAddr,
+		Address:    normalizeAddress(req.Address),
+	}
+	signature, status, err := s.sign(r.Context(), &req, &entry)
+	entry.Allowed = err == nil
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if s.auditLogger != nil {
+		s.auditLogger.Log(entry)
+	}
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SignResponse{Signature: signature})
+}
+
+// sign checks the request against the key policy and signs it, it returns the http status to
+// answer with on error.
+func (s *Server) sign(ctx context.Context, req *SignRequest, entry *AuditEntry) (string, int, error) {
+	s.mu.RLock()
+	key, ok := s.keys[entry.Address]
+	s.mu.RUnlock()
+	if !ok {
+		return "", http.StatusNotFound, ErrKeyNotFound
+	}
+
+	switch {
+	case req.IntentMessage != "" && req.Digest == "":
+		data, err := base64.StdEncoding.DecodeString(req.IntentMessage)
+		if err != nil {
+			return "", http.StatusBadRequest, ErrInvalidIntent
+		}
+		intentMessage, err := models.ParseIntentMessage(data)
+		if err != nil {
+			return "", http.StatusBadRequest, ErrInvalidIntent
+		}
+		scope := intentMessage.Intent.Scope
+		digest := intentMessage.Digest()
+		entry.Scope = &scope
+		entry.Digest = base64.StdEncoding.EncodeToString(digest[:])
+		if err := key.policy.checkScope(scope); err != nil {
+			return "", http.StatusForbidden, err
+		}
+
+		signature, err := models.SignIntentMessage(ctx, key.signer, intentMessage)
+		if err != nil {
+			return "", http.StatusInternalServerError, err
+		}
+		return signature, http.StatusOK, nil
+	case req.Digest != "" && req.IntentMessage == "":
+		digest, err := base64.StdEncoding.DecodeString(req.Digest)
+		if err != nil || len(digest) != blake2b.Size256 {
+			return "", http.StatusBadRequest, errors.New("invalid digest")
+		}
+		entry.Digest = req.Digest
+		if !key.policy.AllowDigest {
+			return "", http.StatusForbidden, ErrPolicyDenied
+		}
+
+		signature, err := models.SignDigest(ctx, key.signer, digest)
+		if err != nil {
+			return "", http.StatusInternalServerError, err
+		}
+		return signature, http.StatusOK, nil
+	default:
+		return "", http.StatusBadRequest, errors.New("exactly one of intentMessage and digest must be set")
+	}
+}
+
+func normalizeAddress(address string) string {
+	return strings.ToLower(address)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,199 @@
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+const (
+	SuiPrivateKeyPrefix = "suiprivkey"
+	SecretKeySize       = 32
+)
+
+var ErrInvalidSecretKey = errors.New("invalid secret key")
+
+// SecretKeySigner is a signer holding its secret key in memory, which can be exported.
+type SecretKeySigner interface {
+	models.Signer
+	// SecretKey returns a copy of the 32 bytes secret key.
+	SecretKey() []byte
+}
+
+var (
+	_ SecretKeySigner = (*Signer)(nil)
+	_ SecretKeySigner = (*Secp256k1Signer)(nil)
+	_ SecretKeySigner = (*Secp256r1Signer)(nil)
+)
+
+// EncodeSuiPrivateKey encodes a secret key as a bech32 `suiprivkey`, the format used by the Sui CLI and wallets.
+func EncodeSuiPrivateKey(signatureScheme scheme.SignatureScheme, secretKey []byte) (string, error) {
+	data, err := flaggedSecretKey(signatureScheme, secretKey)
+	if err != nil {
+		return "", err
+	}
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(SuiPrivateKeyPrefix, converted)
+}
+
+// DecodeSuiPrivateKey decodes a bech32 `suiprivkey` into its scheme and secret key.
+func DecodeSuiPrivateKey(secret string) (scheme.SignatureScheme, []byte, error) {
+	flag, secretKey, err := decodeSuiPrivateKey(secret)
+	if err != nil {
+		return "", nil, err
+	}
+	return schemeAndSecretKey(append([]byte{flag}, secretKey...))
+}
+
+// EncodeBase64PrivateKey encodes a secret key in the legacy base64 `flag || secret_key` format, as
+// stored in the `sui.keystore` file.
+func EncodeBase64PrivateKey(signatureScheme scheme.SignatureScheme, secretKey []byte) (string, error) {
+	data, err := flaggedSecretKey(signatureScheme, secretKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodeBase64PrivateKey decodes a legacy base64 `flag || secret_key` into its scheme and secret key.
+func DecodeBase64PrivateKey(value string) (scheme.SignatureScheme, []byte, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", nil, err
+	}
+	return schemeAndSecretKey(data)
+}
+
+// NewSignerFromSecretKey creates a signer of any mnemonic based scheme from its 32 bytes secret key.
+func NewSignerFromSecretKey(signatureScheme scheme.SignatureScheme, secretKey []byte) (SecretKeySigner, error) {
+	if len(secretKey) != SecretKeySize {
+		return nil, ErrInvalidSecretKey
+	}
+
+	switch signatureScheme {
+	case scheme.ED25519:
+		return NewSigner(secretKey), nil
+	case scheme.Secp256k1:
+		s, err := NewSecp256k1Signer(secretKey)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case scheme.Secp256r1:
+		s, err := NewSecp256r1Signer(secretKey)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+}
+
+// ImportSuiPrivateKey creates a signer from a bech32 `suiprivkey`, the scheme is taken from its flag.
+func ImportSuiPrivateKey(secret string) (SecretKeySigner, error) {
+	signatureScheme, secretKey, err := DecodeSuiPrivateKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	return NewSignerFromSecretKey(signatureScheme, secretKey)
+}
+
+// ImportBase64PrivateKey creates a signer from a legacy base64 `flag || secret_key`.
+func ImportBase64PrivateKey(value string) (SecretKeySigner, error) {
+	signatureScheme, secretKey, err := DecodeBase64PrivateKey(value)
+	if err != nil {
+		return nil, err
+	}
+	return NewSignerFromSecretKey(signatureScheme, secretKey)
+}
+
+// ImportHexPrivateKey creates a signer of the scheme from a hex secret key, with or without the 0x prefix.
+func ImportHexPrivateKey(signatureScheme scheme.SignatureScheme, value string) (SecretKeySigner, error) {
+	secretKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X"))
+	if err != nil {
+		return nil, err
+	}
+	return NewSignerFromSecretKey(signatureScheme, secretKey)
+}
+
+// ImportMnemonic derives a signer of the scheme from a mnemonic, on the default path of the
+// scheme when path is empty. A mnemonic cannot be exported back from a signer.
+func ImportMnemonic(signatureScheme scheme.SignatureScheme, mnemonic string, path string) (SecretKeySigner, error) {
+	switch signatureScheme {
+	case scheme.ED25519:
+		if path == "" {
+			path = DerivationPathEd25519
+		}
+		s, err := NewSignerWithMnemonicAndPath(mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case scheme.Secp256k1:
+		s, err := NewSecp256k1SignerWithMnemonicAndPath(mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case scheme.Secp256r1:
+		s, err := NewSecp256r1SignerWithMnemonicAndPath(mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+}
+
+// ExportSuiPrivateKey exports the secret key of the signer as a bech32 `suiprivkey`.
+func ExportSuiPrivateKey(s SecretKeySigner) (string, error) {
+	return EncodeSuiPrivateKey(s.GetKeyScheme(), s.SecretKey())
+}
+
+// ExportBase64PrivateKey exports the secret key of the signer as a legacy base64 `flag || secret_key`.
+func ExportBase64PrivateKey(s SecretKeySigner) (string, error) {
+	return EncodeBase64PrivateKey(s.GetKeyScheme(), s.SecretKey())
+}
+
+// ExportHexPrivateKey exports the secret key of the signer as 0x prefixed hex, the scheme is not included.
+func ExportHexPrivateKey(s SecretKeySigner) string {
+	return "0x" + hex.EncodeToString(s.SecretKey())
+}
+
+func flaggedSecretKey(signatureScheme scheme.SignatureScheme, secretKey []byte) ([]byte, error) {
+	flag, ok := scheme.SignatureSchemeToFlag[signatureScheme]
+	if !ok || !isSecretKeyScheme(signatureScheme) {
+		return nil, fmt.Errorf("unsupported signature scheme %s", signatureScheme)
+	}
+	if len(secretKey) != SecretKeySize {
+		return nil, ErrInvalidSecretKey
+	}
+	return append([]byte{flag}, secretKey...), nil
+}
+
+func schemeAndSecretKey(data []byte) (scheme.SignatureScheme, []byte, error) {
+	if len(data) != 1+SecretKeySize {
+		return "", nil, ErrInvalidSecretKey
+	}
+	signatureScheme, ok := scheme.SignatureFlagToScheme[data[0]]
+	if !ok || !isSecretKeyScheme(signatureScheme) {
+		return "", nil, fmt.Errorf("Invalid secret key flag: %d", data[0])
+	}
+	return signatureScheme, data[1:], nil
+}
+
+func isSecretKeyScheme(signatureScheme scheme.SignatureScheme) bool {
+	return signatureScheme == scheme.ED25519 || signatureScheme == scheme.Secp256k1 || signatureScheme == scheme.Secp256r1
+}
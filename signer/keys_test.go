@@ -0,0 +1,122 @@
+package signer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+)
+
+func TestImportExportMatrix(t *testing.T) {
+	tests := []struct {
+		name        string
+		scheme      scheme.SignatureScheme
+		mnemonic    string
+		wantAddress string
+	}{
+		{
+			name:     "ed25519",
+			scheme:   scheme.ED25519,
+			mnemonic: "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm",
+		},
+		{
+			name:        "secp256k1",
+			scheme:      scheme.Secp256k1,
+			mnemonic:    "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm",
+			wantAddress: "0x9e8f732575cc5386f8df3c784cd3ed1b53ce538da79926b2ad54dcc1197d2532",
+		},
+		{
+			name:        "secp256r1",
+			scheme:      scheme.Secp256r1,
+			mnemonic:    "act wing dilemma glory episode region allow mad tourist humble muffin oblige",
+			wantAddress: "0x4a822457f1970468d38dae8e63fb60eefdaa497d74d781f581ea2d137ec36f3a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromMnemonic, err := ImportMnemonic(tt.scheme, tt.mnemonic, "")
+			require.NoError(t, err)
+			require.Equal(t, tt.scheme, fromMnemonic.GetKeyScheme())
+			address := fromMnemonic.ToSuiAddress()
+			if tt.wantAddress != "" {
+				require.Equal(t, tt.wantAddress, address)
+			}
+
+			bech32Key, err := ExportSuiPrivateKey(fromMnemonic)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(bech32Key, SuiPrivateKeyPrefix+"1"))
+			base64Key, err := ExportBase64PrivateKey(fromMnemonic)
+			require.NoError(t, err)
+			hexKey := ExportHexPrivateKey(fromMnemonic)
+
+			imports := map[string]func() (SecretKeySigner, error){
+				"bech32": func() (SecretKeySigner, error) { return ImportSuiPrivateKey(bech32Key) },
+				"base64": func() (SecretKeySigner, error) { return ImportBase64PrivateKey(base64Key) },
+				"hex":    func() (SecretKeySigner, error) { return ImportHexPrivateKey(tt.scheme, hexKey) },
+			}
+			for format, importKey := range imports {
+				imported, err := importKey()
+				require.NoError(t, err, format)
+				require.Equal(t, tt.scheme, imported.GetKeyScheme(), format)
+				require.Equal(t, address, imported.ToSuiAddress(), format)
+				require.Equal(t, fromMnemonic.SecretKey(), imported.SecretKey(), format)
+
+				reexported, err := ExportSuiPrivateKey(imported)
+				require.NoError(t, err, format)
+				require.Equal(t, bech32Key, reexported, format)
+			}
+
+			decodedScheme, secretKey, err := DecodeSuiPrivateKey(bech32Key)
+			require.NoError(t, err)
+			require.Equal(t, tt.scheme, decodedScheme)
+			require.Equal(t, fromMnemonic.SecretKey(), secretKey)
+		})
+	}
+}
+
+func TestImportRejectsMismatchedFlag(t *testing.T) {
+	secretKey := append(make([]byte, 31), 1)
+	edKey, err := EncodeSuiPrivateKey(scheme.ED25519, secretKey)
+	require.NoError(t, err)
+	k1Key, err := EncodeSuiPrivateKey(scheme.Secp256k1, secretKey)
+	require.NoError(t, err)
+	r1Key, err := EncodeSuiPrivateKey(scheme.Secp256r1, secretKey)
+	require.NoError(t, err)
+
+	_, err = NewSignerWithSecretKey(edKey)
+	require.NoError(t, err)
+	_, err = NewSignerWithSecretKey(k1Key)
+	require.Error(t, err)
+	_, err = NewSecp256k1SignerWithSecretKey(r1Key)
+	require.Error(t, err)
+	_, err = NewSecp256r1SignerWithSecretKey(k1Key)
+	require.Error(t, err)
+
+	// a multisig flag is not a private key scheme
+	_, err = ImportSuiPrivateKey(encodeSuiPrivateKey(t, 0x03, secretKey))
+	require.Error(t, err)
+	_, err = ImportSuiPrivateKey(encodeSuiPrivateKey(t, SigntureFlagEd25519, secretKey[:16]))
+	require.Error(t, err)
+	_, err = NewSignerWithSecretKey(encodeSuiPrivateKey(t, SigntureFlagEd25519, secretKey[:16]))
+	require.Error(t, err)
+	_, err = ImportBase64PrivateKey("AQID")
+	require.ErrorIs(t, err, ErrInvalidSecretKey)
+	_, err = EncodeSuiPrivateKey(scheme.MultiSig, secretKey)
+	require.Error(t, err)
+	_, err = ImportHexPrivateKey(scheme.ED25519, "0x0102")
+	require.ErrorIs(t, err, ErrInvalidSecretKey)
+
+	// a failed import returns a nil interface, not a nil signer in it
+	for _, signatureScheme := range []scheme.SignatureScheme{scheme.Secp256k1, scheme.Secp256r1} {
+		s, err := NewSignerFromSecretKey(signatureScheme, make([]byte, SecretKeySize))
+		require.Error(t, err)
+		require.True(t, s == nil, signatureScheme)
+	}
+	for _, signatureScheme := range []scheme.SignatureScheme{scheme.ED25519, scheme.Secp256k1, scheme.Secp256r1} {
+		s, err := ImportMnemonic(signatureScheme, "not a mnemonic", "")
+		require.Error(t, err)
+		require.True(t, s == nil, signatureScheme)
+	}
+}
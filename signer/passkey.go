@@ -0,0 +1,65 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/passkey"
+)
+
+// PasskeySigner signs with a WebAuthn authenticator, the challenge is the intent message digest.
+type PasskeySigner struct {
+	PubKey  []byte
+	Address string
+
+	authenticator passkey.Authenticator
+}
+
+func NewPasskeySigner(authenticator passkey.Authenticator) *PasskeySigner {
+	publicKey := passkey.NewPasskeyPublicKey(authenticator.PublicKey())
+	return &PasskeySigner{
+		PubKey:        publicKey.ToRawBytes(),
+		Address:       publicKey.ToSuiAddress(),
+		authenticator: authenticator,
+	}
+}
+
+func (s *PasskeySigner) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.Passkey
+}
+
+func (s *PasskeySigner) GetPublicKey() []byte {
+	return s.PubKey
+}
+
+func (s *PasskeySigner) ToSuiAddress() string {
+	return s.Address
+}
+
+// Sign requests an assertion over the intent message digest and returns the serialized passkey signature.
+func (s *PasskeySigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	assertion, err := s.authenticator.GetAssertion(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator, err := passkey.NewPasskeyAuthenticator(s.PubKey, assertion)
+	if err != nil {
+		return nil, err
+	}
+	return passkey.MarshalPasskeySignature(authenticator)
+}
+
+func (s *PasskeySigner) SignMessage(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessage(s, data, scope)
+}
+
+func (s *PasskeySigner) SignTransaction(b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
+	return signTransaction(s, b64TxBytes)
+}
+
+func (s *PasskeySigner) SignPersonalMessage(message string) (*SignedMessageSerializedSig, error) {
+	return signPersonalMessage(s, message)
+}
@@ -0,0 +1,36 @@
+package signer
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/passkey"
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func TestPasskeySigner(t *testing.T) {
+	authenticator, err := passkey.NewSoftwareAuthenticator("https://www.sui.io", "www.sui.io")
+	require.NoError(t, err)
+	signer := NewPasskeySigner(authenticator)
+
+	signed, err := signer.SignPersonalMessage("hello passkey")
+	require.NoError(t, err)
+	address, pass, err := verify.VerifyPersonalMessageSignature([]byte("hello passkey"), signed.Signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+
+	txBytes := []byte("transaction data")
+	signedTx, err := signer.SignTransaction(base64.StdEncoding.EncodeToString(txBytes))
+	require.NoError(t, err)
+	_, pass, err = verify.VerifyTransactionSignature(txBytes, signedTx.Signature, &verify.VerifyOptions{Address: signer.Address})
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	// the challenge is bound to the intent
+	_, pass, err = verify.VerifyPersonalMessageSignature(txBytes, signedTx.Signature, nil)
+	require.NoError(t, err)
+	require.False(t, pass)
+}
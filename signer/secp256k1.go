@@ -0,0 +1,132 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256k1"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+type Secp256k1Signer struct {
+	PriKey  []byte
+	PubKey  []byte
+	Address string
+
+	keypair *secp256k1.Secp256k1Keypair
+}
+
+func newSecp256k1Signer(keypair *secp256k1.Secp256k1Keypair) *Secp256k1Signer {
+	publicKey := keypair.PublicKey()
+	return &Secp256k1Signer{
+		PriKey:  keypair.SecretKey(),
+		PubKey:  publicKey.ToRawBytes(),
+		Address: publicKey.ToSuiAddress(),
+		keypair: keypair,
+	}
+}
+
+// GenerateSecp256k1Signer creates a signer with a random key.
+func GenerateSecp256k1Signer() (*Secp256k1Signer, error) {
+	keypair, err := secp256k1.GenerateSecp256k1Keypair()
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256k1Signer(keypair), nil
+}
+
+// NewSecp256k1Signer creates a signer from a 32 bytes secret key.
+func NewSecp256k1Signer(secretKey []byte) (*Secp256k1Signer, error) {
+	keypair, err := secp256k1.NewSecp256k1Keypair(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256k1Signer(keypair), nil
+}
+
+// NewSecp256k1SignerWithHex creates a signer from a hex secret key, with or without the 0x prefix.
+func NewSecp256k1SignerWithHex(secretKey string) (*Secp256k1Signer, error) {
+	secretKey = strings.TrimPrefix(strings.TrimPrefix(secretKey, "0x"), "0X")
+	decoded, err := hex.DecodeString(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewSecp256k1Signer(decoded)
+}
+
+// NewSecp256k1SignerWithSecretKey creates a signer from a bech32 `suiprivkey` secret key.
+func NewSecp256k1SignerWithSecretKey(secret string) (*Secp256k1Signer, error) {
+	flag, privKey, err := decodeSuiPrivateKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	if flag != SigntureFlagSecp256k1 {
+		return nil, fmt.Errorf("Invalid secret key flag: %d", flag)
+	}
+	return NewSecp256k1Signer(privKey)
+}
+
+// NewSecp256k1SignerWithMnemonic derives the signer on DerivationPathSecp256k1.
+func NewSecp256k1SignerWithMnemonic(mnemonic string) (*Secp256k1Signer, error) {
+	return NewSecp256k1SignerWithMnemonicAndPath(mnemonic, DerivationPathSecp256k1)
+}
+
+// NewSecp256k1SignerWithMnemonicAndPath derives the signer on a `m/54'/784'/{account}'/{change}/{index}` path.
+func NewSecp256k1SignerWithMnemonicAndPath(mnemonic string, path string) (*Secp256k1Signer, error) {
+	keypair, err := secp256k1.DeriveSecp256k1Keypair(mnemonic, path)
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256k1Signer(keypair), nil
+}
+
+func (s *Secp256k1Signer) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.Secp256k1
+}
+
+func (s *Secp256k1Signer) GetPublicKey() []byte {
+	return s.PubKey
+}
+
+func (s *Secp256k1Signer) ToSuiAddress() string {
+	return s.Address
+}
+
+// Sign signs the intent message digest and returns the raw 64 bytes signature.
+func (s *Secp256k1Signer) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return s.keypair.Sign(digest), nil
+}
+
+func (s *Secp256k1Signer) SignMessage(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessage(s, data, scope)
+}
+
+func (s *Secp256k1Signer) SignTransaction(b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
+	return signTransaction(s, b64TxBytes)
+}
+
+func (s *Secp256k1Signer) SignPersonalMessage(message string) (*SignedMessageSerializedSig, error) {
+	return signPersonalMessage(s, message)
+}
+
+// SignMessageV1 bcs encodes the message before signing it, as required for personal messages.
+func (s *Secp256k1Signer) SignMessageV1(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessageV1(s, data, scope)
+}
+
+// SecretKey returns a copy of the 32 bytes secret key.
+func (s *Secp256k1Signer) SecretKey() []byte {
+	return append([]byte(nil), s.PriKey...)
+}
+
+// Zero clears the secret key from memory, the signer must not be used afterwards.
+func (s *Secp256k1Signer) Zero() {
+	for i := range s.PriKey {
+		s.PriKey[i] = 0
+	}
+	s.keypair.Zero()
+}
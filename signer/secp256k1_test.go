@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func encodeSuiPrivateKey(t *testing.T, flag byte, secretKey []byte) string {
+	data, err := bech32.ConvertBits(append([]byte{flag}, secretKey...), 8, 5, true)
+	require.NoError(t, err)
+	secret, err := bech32.Encode("suiprivkey", data)
+	require.NoError(t, err)
+	return secret
+}
+
+func TestSecp256k1Signer(t *testing.T) {
+	mnemonic := "film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm"
+	signer, err := NewSecp256k1SignerWithMnemonic(mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, "0x9e8f732575cc5386f8df3c784cd3ed1b53ce538da79926b2ad54dcc1197d2532", signer.Address)
+
+	fromHex, err := NewSecp256k1SignerWithHex("0x" + hex.EncodeToString(signer.PriKey))
+	require.NoError(t, err)
+	require.Equal(t, signer.Address, fromHex.Address)
+
+	fromBech32, err := NewSecp256k1SignerWithSecretKey(encodeSuiPrivateKey(t, SigntureFlagSecp256k1, signer.PriKey))
+	require.NoError(t, err)
+	require.Equal(t, signer.Address, fromBech32.Address)
+
+	_, err = NewSecp256k1SignerWithSecretKey(encodeSuiPrivateKey(t, SigntureFlagEd25519, signer.PriKey))
+	require.Error(t, err)
+
+	signed, err := signer.SignPersonalMessage("hello sui")
+	require.NoError(t, err)
+	address, pass, err := verify.VerifyPersonalMessageSignature([]byte("hello sui"), signed.Signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+
+	txBytes := []byte("transaction data")
+	signedTx, err := signer.SignTransaction(base64.StdEncoding.EncodeToString(txBytes))
+	require.NoError(t, err)
+	address, pass, err = verify.VerifyTransactionSignature(txBytes, signedTx.Signature, &verify.VerifyOptions{Address: signer.Address})
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+}
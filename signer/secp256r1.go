@@ -0,0 +1,132 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+	"github.com/block-vision/sui-go-sdk/models"
+)
+
+type Secp256r1Signer struct {
+	PriKey  []byte
+	PubKey  []byte
+	Address string
+
+	keypair *secp256r1.Secp256r1Keypair
+}
+
+func newSecp256r1Signer(keypair *secp256r1.Secp256r1Keypair) *Secp256r1Signer {
+	publicKey := keypair.PublicKey()
+	return &Secp256r1Signer{
+		PriKey:  keypair.SecretKey(),
+		PubKey:  publicKey.ToRawBytes(),
+		Address: publicKey.ToSuiAddress(),
+		keypair: keypair,
+	}
+}
+
+// GenerateSecp256r1Signer creates a signer with a random key.
+func GenerateSecp256r1Signer() (*Secp256r1Signer, error) {
+	keypair, err := secp256r1.GenerateSecp256r1Keypair()
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256r1Signer(keypair), nil
+}
+
+// NewSecp256r1Signer creates a signer from a 32 bytes secret key.
+func NewSecp256r1Signer(secretKey []byte) (*Secp256r1Signer, error) {
+	keypair, err := secp256r1.NewSecp256r1Keypair(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256r1Signer(keypair), nil
+}
+
+// NewSecp256r1SignerWithHex creates a signer from a hex secret key, with or without the 0x prefix.
+func NewSecp256r1SignerWithHex(secretKey string) (*Secp256r1Signer, error) {
+	secretKey = strings.TrimPrefix(strings.TrimPrefix(secretKey, "0x"), "0X")
+	decoded, err := hex.DecodeString(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewSecp256r1Signer(decoded)
+}
+
+// NewSecp256r1SignerWithSecretKey creates a signer from a bech32 `suiprivkey` secret key.
+func NewSecp256r1SignerWithSecretKey(secret string) (*Secp256r1Signer, error) {
+	flag, privKey, err := decodeSuiPrivateKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	if flag != SigntureFlagSecp256r1 {
+		return nil, fmt.Errorf("Invalid secret key flag: %d", flag)
+	}
+	return NewSecp256r1Signer(privKey)
+}
+
+// NewSecp256r1SignerWithMnemonic derives the signer on DerivationPathSecp256r1.
+func NewSecp256r1SignerWithMnemonic(mnemonic string) (*Secp256r1Signer, error) {
+	return NewSecp256r1SignerWithMnemonicAndPath(mnemonic, DerivationPathSecp256r1)
+}
+
+// NewSecp256r1SignerWithMnemonicAndPath derives the signer on a `m/74'/784'/{account}'/{change}/{index}` path.
+func NewSecp256r1SignerWithMnemonicAndPath(mnemonic string, path string) (*Secp256r1Signer, error) {
+	keypair, err := secp256r1.DeriveSecp256r1Keypair(mnemonic, path)
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256r1Signer(keypair), nil
+}
+
+func (s *Secp256r1Signer) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.Secp256r1
+}
+
+func (s *Secp256r1Signer) GetPublicKey() []byte {
+	return s.PubKey
+}
+
+func (s *Secp256r1Signer) ToSuiAddress() string {
+	return s.Address
+}
+
+// Sign signs the intent message digest and returns the raw 64 bytes signature.
+func (s *Secp256r1Signer) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return s.keypair.Sign(digest)
+}
+
+func (s *Secp256r1Signer) SignMessage(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessage(s, data, scope)
+}
+
+func (s *Secp256r1Signer) SignTransaction(b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
+	return signTransaction(s, b64TxBytes)
+}
+
+func (s *Secp256r1Signer) SignPersonalMessage(message string) (*SignedMessageSerializedSig, error) {
+	return signPersonalMessage(s, message)
+}
+
+// SignMessageV1 bcs encodes the message before signing it, as required for personal messages.
+func (s *Secp256r1Signer) SignMessageV1(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessageV1(s, data, scope)
+}
+
+// SecretKey returns a copy of the 32 bytes secret key.
+func (s *Secp256r1Signer) SecretKey() []byte {
+	return append([]byte(nil), s.PriKey...)
+}
+
+// Zero clears the secret key from memory, the signer must not be used afterwards.
+func (s *Secp256r1Signer) Zero() {
+	for i := range s.PriKey {
+		s.PriKey[i] = 0
+	}
+	s.keypair.Zero()
+}
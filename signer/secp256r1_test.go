@@ -0,0 +1,44 @@
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func TestSecp256r1Signer(t *testing.T) {
+	mnemonic := "act wing dilemma glory episode region allow mad tourist humble muffin oblige"
+	signer, err := NewSecp256r1SignerWithMnemonic(mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, "0x4a822457f1970468d38dae8e63fb60eefdaa497d74d781f581ea2d137ec36f3a", signer.Address)
+
+	fromHex, err := NewSecp256r1SignerWithHex("0x" + hex.EncodeToString(signer.PriKey))
+	require.NoError(t, err)
+	require.Equal(t, signer.Address, fromHex.Address)
+
+	fromBech32, err := NewSecp256r1SignerWithSecretKey(encodeSuiPrivateKey(t, SigntureFlagSecp256r1, signer.PriKey))
+	require.NoError(t, err)
+	require.Equal(t, signer.Address, fromBech32.Address)
+
+	_, err = NewSecp256r1SignerWithSecretKey(encodeSuiPrivateKey(t, SigntureFlagEd25519, signer.PriKey))
+	require.Error(t, err)
+
+	signed, err := signer.SignPersonalMessage("hello sui")
+	require.NoError(t, err)
+	address, pass, err := verify.VerifyPersonalMessageSignature([]byte("hello sui"), signed.Signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+
+	txBytes := []byte("transaction data")
+	signedTx, err := signer.SignTransaction(base64.StdEncoding.EncodeToString(txBytes))
+	require.NoError(t, err)
+	address, pass, err = verify.VerifyTransactionSignature(txBytes, signedTx.Signature, &verify.VerifyOptions{Address: signer.Address})
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+}
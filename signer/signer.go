@@ -1,28 +1,28 @@
 package signer
 
 import (
-	"bytes"
-	"crypto"
+	"context"
 	"crypto/ed25519"
 	"encoding/base64"
-	"encoding/hex"
 	"fmt"
 
 	"github.com/block-vision/sui-go-sdk/common/keypair"
 	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 	"github.com/btcsuite/btcutil/bech32"
 	"github.com/cosmos/go-bip39"
-	"golang.org/x/crypto/blake2b"
 )
 
 const (
 	SigntureFlagEd25519     = 0x0
 	SigntureFlagSecp256k1   = 0x1
+	SigntureFlagSecp256r1   = 0x2
 	AddressLength           = 64
 	DerivationPathEd25519   = `m/44'/784'/0'/0'/0'`
 	DerivationPathSecp256k1 = `m/54'/784'/0'/0/0`
+	DerivationPathSecp256r1 = `m/74'/784'/0'/0/0`
 )
 
 type Signer struct {
@@ -35,115 +35,194 @@ func NewSigner(seed []byte) *Signer {
 	priKey := ed25519.NewKeyFromSeed(seed[:])
 	pubKey := priKey.Public().(ed25519.PublicKey)
 
-	tmp := []byte{byte(keypair.Ed25519Flag)}
-	tmp = append(tmp, pubKey...)
-	addrBytes := blake2b.Sum256(tmp)
-	addr := "0x" + hex.EncodeToString(addrBytes[:])[:AddressLength]
-
 	return &Signer{
 		PriKey:  priKey,
 		PubKey:  pubKey,
-		Address: addr,
+		Address: models.PublicKeyToSuiAddress(byte(keypair.Ed25519Flag), pubKey),
 	}
 }
 
+// NewSignerWithSecretKey creates an ed25519 signer from a bech32 `suiprivkey` secret key, keys of
+// other schemes are rejected. Use ImportSuiPrivateKey to accept any scheme.
 func NewSignerWithSecretKey(secret string) (*Signer, error) {
-	hrp, data, err := bech32.Decode(secret)
+	flag, privKey, err := decodeSuiPrivateKey(secret)
 	if err != nil {
 		return nil, err
 	}
+	if flag != SigntureFlagEd25519 {
+		return nil, fmt.Errorf("Invalid secret key flag: %d", flag)
+	}
+	if len(privKey) != ed25519.SeedSize {
+		return nil, ErrInvalidSecretKey
+	}
+	return NewSigner(privKey), nil
+}
+
+// decodeSuiPrivateKey decodes a bech32 `suiprivkey` into the scheme flag and the secret key.
+func decodeSuiPrivateKey(secret string) (byte, []byte, error) {
+	hrp, data, err := bech32.Decode(secret)
+	if err != nil {
+		return 0, nil, err
+	}
 	if hrp != "suiprivkey" {
-		return nil, fmt.Errorf("Invalid bech32 prefix: %s", hrp)
+		return 0, nil, fmt.Errorf("Invalid bech32 prefix: %s", hrp)
 	}
 
 	// bech32 5bit to 8bit
 	decoded, err := bech32.ConvertBits(data, 5, 8, false)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
 	}
 	if len(decoded) < 2 {
-		return nil, fmt.Errorf("Invalid bech32 data length: %d", len(decoded))
+		return 0, nil, fmt.Errorf("Invalid bech32 data length: %d", len(decoded))
 	}
 
-	privKey := decoded[1:]
-	return NewSigner(privKey), nil
+	return decoded[0], decoded[1:], nil
 }
 
 func NewSignertWithMnemonic(mnemonic string) (*Signer, error) {
+	return NewSignerWithMnemonicAndPath(mnemonic, DerivationPathEd25519)
+}
+
+// NewSignerWithMnemonicAndPath derives an ed25519 signer from a mnemonic on a fully hardened
+// `m/44'/784'/{account}'/{change}'/{index}'` path.
+func NewSignerWithMnemonicAndPath(mnemonic string, path string) (*Signer, error) {
 	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
 	if err != nil {
 		return nil, err
 	}
-	key, err := DeriveForPath("m/44'/784'/0'/0'/0'", seed)
+	key, err := DeriveForPath(path, seed)
 	if err != nil {
 		return nil, err
 	}
 	return NewSigner(key.Key), nil
 }
 
+func (s *Signer) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.ED25519
+}
+
+func (s *Signer) GetPublicKey() []byte {
+	return s.PubKey
+}
+
+func (s *Signer) ToSuiAddress() string {
+	return s.Address
+}
+
+// Sign signs the intent message digest and returns the raw 64 bytes signature.
+func (s *Signer) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return ed25519.Sign(s.PriKey, digest), nil
+}
+
+// SecretKey returns the 32 bytes ed25519 seed.
+func (s *Signer) SecretKey() []byte {
+	return append([]byte(nil), s.PriKey.Seed()...)
+}
+
+// Zero clears the private key from memory, the signer must not be used afterwards.
+func (s *Signer) Zero() {
+	for i := range s.PriKey {
+		s.PriKey[i] = 0
+	}
+}
+
 type SignedMessageSerializedSig struct {
 	Message   string `json:"message"`
 	Signature string `json:"signature"`
 }
 
 func (s *Signer) SignMessage(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
-	txBytes, _ := base64.StdEncoding.DecodeString(data)
-	message := models.NewMessageWithIntent(txBytes, scope)
-	digest := blake2b.Sum256(message)
-	var noHash crypto.Hash
-	sigBytes, err := s.PriKey.Sign(nil, digest[:], noHash)
+	return signMessage(s, data, scope)
+}
+
+// SignTransaction signs base64 TransactionData bytes with the transaction intent.
+func (s *Signer) SignTransaction(b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
+	return signTransaction(s, b64TxBytes)
+}
+
+// SignPersonalMessage signs the message with the personal message intent, the message is BCS
+// serialized first as the Sui spec requires.
+func (s *Signer) SignPersonalMessage(message string) (*SignedMessageSerializedSig, error) {
+	return signPersonalMessage(s, message)
+}
+
+// SignPersonalMessageV1 is the same as SignPersonalMessage.
+//
+// Deprecated: SignPersonalMessage BCS serializes the message now, use it instead.
+func (s *Signer) SignPersonalMessageV1(message string) (*SignedMessageSerializedSig, error) {
+	return s.SignPersonalMessage(message)
+}
+
+// SignMessageV1 is the same as SignMessage, but it uses the new message format for personal messages.
+func (s *Signer) SignMessageV1(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	return signMessageV1(s, data, scope)
+}
+
+// signMessage signs the base64 data with the intent of the scope, it is shared by all the signers.
+func signMessage(s models.Signer, data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	message, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 message: %v", err)
+	}
+	signature, err := models.SignWithIntent(context.Background(), s, message, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	ret := &SignedMessageSerializedSig{
+	return &SignedMessageSerializedSig{
 		Message:   data,
-		Signature: models.ToSerializedSignature(sigBytes, s.PriKey.Public().(ed25519.PublicKey)),
-	}
-	return ret, nil
+		Signature: signature,
+	}, nil
 }
 
-func (s *Signer) SignTransaction(b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
-	result, err := s.SignMessage(b64TxBytes, constant.PersonalMessageIntentScope)
+// signMessageV1 bcs encodes the base64 data before signing it with the intent of the scope.
+func signMessageV1(s models.Signer, data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
+	message, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 message: %v", err)
+	}
+	bcsMessage, err := mystenbcs.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := models.SignWithIntent(context.Background(), s, bcsMessage, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	return &models.SignedTransactionSerializedSig{
-		TxBytes:   result.Message,
-		Signature: result.Signature,
+	return &SignedMessageSerializedSig{
+		Message:   data,
+		Signature: signature,
 	}, nil
 }
 
-func (s *Signer) SignPersonalMessage(message string) (*SignedMessageSerializedSig, error) {
-	b64Message := base64.StdEncoding.EncodeToString([]byte(message))
-	return s.SignMessage(b64Message, constant.PersonalMessageIntentScope)
-}
+// signTransaction signs base64 TransactionData bytes with the transaction intent.
+func signTransaction(s models.Signer, b64TxBytes string) (*models.SignedTransactionSerializedSig, error) {
+	txBytes, err := base64.StdEncoding.DecodeString(b64TxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 transaction bytes: %v", err)
+	}
+	signature, err := models.SignTransactionData(context.Background(), s, txBytes)
+	if err != nil {
+		return nil, err
+	}
 
-// SignPersonalMessageV1 is the same as SignPersonalMessage, but it uses the new message format for personal messages.
-func (s *Signer) SignPersonalMessageV1(message string) (*SignedMessageSerializedSig, error) {
-	b64Message := base64.StdEncoding.EncodeToString([]byte(message))
-	return s.SignMessageV1(b64Message, constant.PersonalMessageIntentScope)
+	return &models.SignedTransactionSerializedSig{
+		TxBytes:   b64TxBytes,
+		Signature: signature,
+	}, nil
 }
 
-// SignMessageV1 is the same as SignMessage, but it uses the new message format for personal messages.
-func (s *Signer) SignMessageV1(data string, scope constant.IntentScope) (*SignedMessageSerializedSig, error) {
-	b64Bytes, _ := base64.StdEncoding.DecodeString(data)
-
-	bcsEncodedMsg := bytes.Buffer{}
-	bcsEncoder := mystenbcs.NewEncoder(&bcsEncodedMsg)
-	bcsEncoder.Encode(b64Bytes)
-	message := models.NewMessageWithIntent(bcsEncodedMsg.Bytes(), scope)
-	digest := blake2b.Sum256(message)
-	var noHash crypto.Hash
-	sigBytes, err := s.PriKey.Sign(nil, digest[:], noHash)
+// signPersonalMessage signs the message with the personal message intent.
+func signPersonalMessage(s models.Signer, message string) (*SignedMessageSerializedSig, error) {
+	signature, err := models.SignPersonalMessage(context.Background(), s, []byte(message))
 	if err != nil {
 		return nil, err
 	}
 
-	ret := &SignedMessageSerializedSig{
-		Message:   data,
-		Signature: models.ToSerializedSignature(sigBytes, s.PriKey.Public().(ed25519.PublicKey)),
-	}
-	return ret, nil
+	return &SignedMessageSerializedSig{
+		Message:   base64.StdEncoding.EncodeToString([]byte(message)),
+		Signature: signature,
+	}, nil
 }
@@ -0,0 +1,87 @@
+package signer
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/verify"
+)
+
+func TestSignerIntents(t *testing.T) {
+	signer := NewSigner(append(make([]byte, 31), 1))
+	txBytes := []byte("transaction data")
+
+	signedTx, err := signer.SignTransaction(base64.StdEncoding.EncodeToString(txBytes))
+	require.NoError(t, err)
+	address, pass, err := verify.VerifyTransactionSignature(txBytes, signedTx.Signature, &verify.VerifyOptions{Address: signer.Address})
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+	_, pass, err = verify.VerifyPersonalMessageSignature(txBytes, signedTx.Signature, nil)
+	require.NoError(t, err)
+	require.False(t, pass)
+
+	signature, err := models.SignTransactionData(context.Background(), signer, txBytes)
+	require.NoError(t, err)
+	require.Equal(t, signedTx.Signature, signature)
+	_, pass, err = models.VerifyTransaction(base64.StdEncoding.EncodeToString(txBytes), signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	message := []byte("hello sui")
+	signedMessage, err := signer.SignPersonalMessage(string(message))
+	require.NoError(t, err)
+	signedMessageV1, err := signer.SignPersonalMessageV1(string(message))
+	require.NoError(t, err)
+	require.Equal(t, signedMessage.Signature, signedMessageV1.Signature)
+	signature, err = models.SignPersonalMessage(context.Background(), signer, message)
+	require.NoError(t, err)
+	require.Equal(t, signedMessage.Signature, signature)
+
+	address, pass, err = verify.VerifyPersonalMessageSignature(message, signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+	address, pass, err = verify.VerifyIntentMessageSignature(models.NewPersonalMessageIntentMessage(message), signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, signer.Address, address)
+	_, pass, err = models.VerifyPersonalMessage(string(message), signature)
+	require.NoError(t, err)
+	require.True(t, pass)
+}
+
+// TestSignerVectors checks the signatures of the ed25519 key of the TS SDK keypair tests. The
+// signatures were computed with the RFC 8032 reference implementation over the blake2b digest of
+// the intent message, ed25519 signatures are deterministic so every SDK produces the same ones.
+func TestSignerVectors(t *testing.T) {
+	signer, err := NewSignerWithSecretKey("suiprivkey1qrwsjvr6gwaxmsvxk4cfun99ra8uwxg3c9pl0nhle7xxpe4s80y05ctazer")
+	require.NoError(t, err)
+	require.Equal(t, "0xa2d14fad60c56049ecf75246a481934691214ce413e6a8ae2fe6834c173a6133", signer.Address)
+
+	signedMessage, err := signer.SignPersonalMessage("Hello, world!")
+	require.NoError(t, err)
+	require.Equal(t, "AOUglU5IJ9By0XVbIAItdFSOp1T67fSXob6Q3qAa8fBKjKq4WUAYCpcGHM6VkRhKT07WPzJXmcPxxZBFOIOQ7QciZH/u7zYwYL1CBaFnGhXxChI2dlkYsbX2ONgvM8/EaQ==", signedMessage.Signature)
+
+	b64TxBytes := "AAACAAgA4fUFAAAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAkCAgABAQAAAQECAAABAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgFhYmNhYmNhYmNhYmNhYmNhYmNhYmNhYmNhYmNhYmNhYgIAAAAAAAAAIAABAgMEBQYHCAkAAQIDBAUGBwgJAAECAwQFBgcICQECAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAYFAAAAAAAAAGQAAAAAAAAAAA=="
+	signedTx, err := signer.SignTransaction(b64TxBytes)
+	require.NoError(t, err)
+	require.Equal(t, "ABWCjYZ5pmj6tW4LHMuTYW5Kfft6+y1/CUa9hM/taNb2ephQY1szVLesReTj/zaK/JD3naBmH+zubrvYluuNQwkiZH/u7zYwYL1CBaFnGhXxChI2dlkYsbX2ONgvM8/EaQ==", signedTx.Signature)
+	require.Equal(t, b64TxBytes, signedTx.TxBytes)
+}
+
+func TestSignerInvalidBase64(t *testing.T) {
+	signer := NewSigner(append(make([]byte, 31), 1))
+
+	_, err := signer.SignTransaction("not base64!")
+	require.Error(t, err)
+	_, err = signer.SignMessage("not base64!", constant.PersonalMessageIntentScope)
+	require.Error(t, err)
+	_, err = signer.SignMessageV1("not base64!", constant.PersonalMessageIntentScope)
+	require.Error(t, err)
+}
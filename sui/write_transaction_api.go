@@ -5,7 +5,10 @@ package sui
 
 import (
 	"context"
+	"encoding/base64"
+
 	"github.com/block-vision/sui-go-sdk/common/httpconn"
+	"github.com/block-vision/sui-go-sdk/constant"
 	"github.com/block-vision/sui-go-sdk/models"
 )
 
@@ -152,7 +155,23 @@ func (s *suiWriteTransactionImpl) BatchTransaction(ctx context.Context, req mode
 // SignAndExecuteTransactionBlock sign a transaction block and submit to the Fullnode for execution.
 func (s *suiWriteTransactionImpl) SignAndExecuteTransactionBlock(ctx context.Context, req models.SignAndExecuteTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
 	var rsp models.SuiTransactionBlockResponse
-	signedTxn := req.TxnMetaData.SignSerializedSigWith(req.PriKey)
+	var signedTxn *models.SignedTransactionSerializedSig
+	if req.Signer != nil {
+		txBytes, err := base64.StdEncoding.DecodeString(req.TxnMetaData.TxBytes)
+		if err != nil {
+			return rsp, err
+		}
+		signature, err := models.SignWithIntent(ctx, req.Signer, txBytes, constant.TransactionDataIntentScope)
+		if err != nil {
+			return rsp, err
+		}
+		signedTxn = &models.SignedTransactionSerializedSig{
+			TxBytes:   req.TxnMetaData.TxBytes,
+			Signature: signature,
+		}
+	} else {
+		signedTxn = req.TxnMetaData.SignSerializedSigWith(req.PriKey)
+	}
 	params := []interface{}{signedTxn.TxBytes, []string{signedTxn.Signature}, req.Options, req.RequestType}
 	err := s.handler.ExecuteRequest(ctx, "sui_executeTransactionBlock", params, &rsp)
 	return rsp, err
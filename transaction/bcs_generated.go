@@ -0,0 +1,1215 @@
+// Code generated by bcsgen. DO NOT EDIT.
+
+package transaction
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func (v TransactionData) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *TransactionData) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.V1 != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = v.V1.AppendBCS(b); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *TransactionData) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *TransactionData) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.V1 == nil {
+			v.V1 = new(TransactionDataV1)
+		}
+		v.V1.DecodeBCS(r)
+	default:
+		r.FailUnknownVariant(index1, "transaction.TransactionData")
+	}
+	r.Leave()
+}
+
+func (v TransactionDataV1) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *TransactionDataV1) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Kind.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if b, err = v.Sender.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if b, err = v.GasData.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if v.Expiration == nil {
+		b = append(b, 0)
+	} else {
+		b = append(b, 1)
+		if b, err = mystenbcs.Append(b, v.Expiration.None); err != nil {
+			return b, err
+		}
+		if v.Expiration.Epoch != nil {
+			b = binary.LittleEndian.AppendUint64(b, uint64(*v.Expiration.Epoch))
+		}
+	}
+	return b, nil
+}
+
+func (v *TransactionDataV1) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *TransactionDataV1) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if v.Kind == nil {
+		v.Kind = new(TransactionKind)
+	}
+	v.Kind.DecodeBCS(r)
+	if v.Sender == nil {
+		v.Sender = new(models.SuiAddressBytes)
+	}
+	v.Sender.DecodeBCS(r)
+	if v.GasData == nil {
+		v.GasData = new(GasData)
+	}
+	v.GasData.DecodeBCS(r)
+	if r.ReadBool() {
+		v.Expiration = new(TransactionExpiration)
+		r.Enter()
+		r.Decode(&v.Expiration.None)
+		if v.Expiration.Epoch == nil {
+			v.Expiration.Epoch = new(uint64)
+		}
+		*v.Expiration.Epoch = r.ReadUint64()
+		r.Leave()
+	} else {
+		v.Expiration = nil
+	}
+	r.Leave()
+}
+
+func (v GasData) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *GasData) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if v.Payment != nil {
+		b = mystenbcs.AppendULEB128(b, len(*v.Payment))
+		for i1 := range *v.Payment {
+			if b, err = (*v.Payment)[i1].AppendBCS(b); err != nil {
+				return b, err
+			}
+		}
+	}
+	if b, err = v.Owner.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if v.Price != nil {
+		b = binary.LittleEndian.AppendUint64(b, uint64(*v.Price))
+	}
+	if v.Budget != nil {
+		b = binary.LittleEndian.AppendUint64(b, uint64(*v.Budget))
+	}
+	return b, nil
+}
+
+func (v *GasData) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *GasData) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if v.Payment == nil {
+		v.Payment = new([]SuiObjectRef)
+	}
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]SuiObjectRef, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 SuiObjectRef
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	*v.Payment = slice2
+	if v.Owner == nil {
+		v.Owner = new(models.SuiAddressBytes)
+	}
+	v.Owner.DecodeBCS(r)
+	if v.Price == nil {
+		v.Price = new(uint64)
+	}
+	*v.Price = r.ReadUint64()
+	if v.Budget == nil {
+		v.Budget = new(uint64)
+	}
+	*v.Budget = r.ReadUint64()
+	r.Leave()
+}
+
+func (v ProgrammableTransaction) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ProgrammableTransaction) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.Inputs))
+	for i1 := range v.Inputs {
+		if b, err = v.Inputs[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Commands))
+	for i2 := range v.Commands {
+		if b, err = v.Commands[i2].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *ProgrammableTransaction) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ProgrammableTransaction) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*CallArg, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(CallArg)
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Inputs = slice2
+	size5 := r.ReadLen()
+	r.Enter()
+	slice6 := make([]*Command, 0, min(size5, mystenbcs.MaxPrealloc))
+	for i7 := 0; i7 < size5 && r.Err() == nil; i7++ {
+		elem8 := new(Command)
+		elem8.DecodeBCS(r)
+		slice6 = append(slice6, elem8)
+	}
+	r.Leave()
+	v.Commands = slice6
+	r.Leave()
+}
+
+func (v TransactionKind) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *TransactionKind) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.ProgrammableTransaction != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = v.ProgrammableTransaction.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.ChangeEpoch != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		if b, err = mystenbcs.Append(b, v.ChangeEpoch); err != nil {
+			return b, err
+		}
+	case v.Genesis != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		if b, err = mystenbcs.Append(b, v.Genesis); err != nil {
+			return b, err
+		}
+	case v.ConsensusCommitPrologue != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		if b, err = mystenbcs.Append(b, v.ConsensusCommitPrologue); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *TransactionKind) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *TransactionKind) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.ProgrammableTransaction == nil {
+			v.ProgrammableTransaction = new(ProgrammableTransaction)
+		}
+		v.ProgrammableTransaction.DecodeBCS(r)
+	case 1:
+		if v.ChangeEpoch == nil {
+			v.ChangeEpoch = struct{}{}
+		} else {
+			r.Decode(&v.ChangeEpoch)
+		}
+	case 2:
+		if v.Genesis == nil {
+			v.Genesis = struct{}{}
+		} else {
+			r.Decode(&v.Genesis)
+		}
+	case 3:
+		if v.ConsensusCommitPrologue == nil {
+			v.ConsensusCommitPrologue = struct{}{}
+		} else {
+			r.Decode(&v.ConsensusCommitPrologue)
+		}
+	default:
+		r.FailUnknownVariant(index1, "transaction.TransactionKind")
+	}
+	r.Leave()
+}
+
+func (v CallArg) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *CallArg) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.Pure != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = v.Pure.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.Object != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		if b, err = v.Object.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.UnresolvedPure != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		if b, err = v.UnresolvedPure.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.UnresolvedObject != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		if b, err = v.UnresolvedObject.AppendBCS(b); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *CallArg) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *CallArg) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.Pure == nil {
+			v.Pure = new(Pure)
+		}
+		v.Pure.DecodeBCS(r)
+	case 1:
+		if v.Object == nil {
+			v.Object = new(ObjectArg)
+		}
+		v.Object.DecodeBCS(r)
+	case 2:
+		if v.UnresolvedPure == nil {
+			v.UnresolvedPure = new(UnresolvedPure)
+		}
+		v.UnresolvedPure.DecodeBCS(r)
+	case 3:
+		if v.UnresolvedObject == nil {
+			v.UnresolvedObject = new(UnresolvedObject)
+		}
+		v.UnresolvedObject.DecodeBCS(r)
+	default:
+		r.FailUnknownVariant(index1, "transaction.CallArg")
+	}
+	r.Leave()
+}
+
+func (v Pure) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *Pure) AppendBCS(b []byte) ([]byte, error) {
+	b = mystenbcs.AppendULEB128(b, len(v.Bytes))
+	b = append(b, v.Bytes...)
+	return b, nil
+}
+
+func (v *Pure) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *Pure) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if data1 := r.ReadBytes(); data1 != nil {
+		v.Bytes = data1
+	}
+	r.Leave()
+}
+
+func (v UnresolvedPure) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *UnresolvedPure) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = mystenbcs.Append(b, v.Value); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *UnresolvedPure) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *UnresolvedPure) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	r.Decode(&v.Value)
+	r.Leave()
+}
+
+func (v UnresolvedObject) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *UnresolvedObject) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.ObjectId.AppendBCS(b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *UnresolvedObject) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *UnresolvedObject) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.ObjectId.DecodeBCS(r)
+	r.Leave()
+}
+
+func (v ObjectArg) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ObjectArg) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.ImmOrOwnedObject != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = v.ImmOrOwnedObject.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.SharedObject != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		if b, err = v.SharedObject.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.Receiving != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		if b, err = v.Receiving.AppendBCS(b); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *ObjectArg) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ObjectArg) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.ImmOrOwnedObject == nil {
+			v.ImmOrOwnedObject = new(SuiObjectRef)
+		}
+		v.ImmOrOwnedObject.DecodeBCS(r)
+	case 1:
+		if v.SharedObject == nil {
+			v.SharedObject = new(SharedObjectRef)
+		}
+		v.SharedObject.DecodeBCS(r)
+	case 2:
+		if v.Receiving == nil {
+			v.Receiving = new(SuiObjectRef)
+		}
+		v.Receiving.DecodeBCS(r)
+	default:
+		r.FailUnknownVariant(index1, "transaction.ObjectArg")
+	}
+	r.Leave()
+}
+
+func (v Command) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *Command) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.MoveCall != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = v.MoveCall.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.TransferObjects != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		if b, err = v.TransferObjects.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.SplitCoins != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		if b, err = v.SplitCoins.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.MergeCoins != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		if b, err = v.MergeCoins.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.Publish != nil:
+		b = mystenbcs.AppendULEB128(b, 4)
+		if b, err = v.Publish.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.MakeMoveVec != nil:
+		b = mystenbcs.AppendULEB128(b, 5)
+		if b, err = v.MakeMoveVec.AppendBCS(b); err != nil {
+			return b, err
+		}
+	case v.Upgrade != nil:
+		b = mystenbcs.AppendULEB128(b, 6)
+		if b, err = v.Upgrade.AppendBCS(b); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *Command) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *Command) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.MoveCall == nil {
+			v.MoveCall = new(ProgrammableMoveCall)
+		}
+		v.MoveCall.DecodeBCS(r)
+	case 1:
+		if v.TransferObjects == nil {
+			v.TransferObjects = new(TransferObjects)
+		}
+		v.TransferObjects.DecodeBCS(r)
+	case 2:
+		if v.SplitCoins == nil {
+			v.SplitCoins = new(SplitCoins)
+		}
+		v.SplitCoins.DecodeBCS(r)
+	case 3:
+		if v.MergeCoins == nil {
+			v.MergeCoins = new(MergeCoins)
+		}
+		v.MergeCoins.DecodeBCS(r)
+	case 4:
+		if v.Publish == nil {
+			v.Publish = new(Publish)
+		}
+		v.Publish.DecodeBCS(r)
+	case 5:
+		if v.MakeMoveVec == nil {
+			v.MakeMoveVec = new(MakeMoveVec)
+		}
+		v.MakeMoveVec.DecodeBCS(r)
+	case 6:
+		if v.Upgrade == nil {
+			v.Upgrade = new(Upgrade)
+		}
+		v.Upgrade.DecodeBCS(r)
+	default:
+		r.FailUnknownVariant(index1, "transaction.Command")
+	}
+	r.Leave()
+}
+
+func (v ProgrammableMoveCall) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ProgrammableMoveCall) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Package.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Module))
+	b = append(b, v.Module...)
+	b = mystenbcs.AppendULEB128(b, len(v.Function))
+	b = append(b, v.Function...)
+	b = mystenbcs.AppendULEB128(b, len(v.TypeArguments))
+	for i1 := range v.TypeArguments {
+		data2, err := v.TypeArguments[i1].MarshalBCS()
+		if err != nil {
+			return b, err
+		}
+		b = append(b, data2...)
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Arguments))
+	for i3 := range v.Arguments {
+		if b, err = v.Arguments[i3].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *ProgrammableMoveCall) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ProgrammableMoveCall) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.Package.DecodeBCS(r)
+	v.Module = r.ReadString()
+	v.Function = r.ReadString()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*TypeTag, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(TypeTag)
+		r.Unmarshal(elem4)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.TypeArguments = slice2
+	size5 := r.ReadLen()
+	r.Enter()
+	slice6 := make([]*Argument, 0, min(size5, mystenbcs.MaxPrealloc))
+	for i7 := 0; i7 < size5 && r.Err() == nil; i7++ {
+		elem8 := new(Argument)
+		elem8.DecodeBCS(r)
+		slice6 = append(slice6, elem8)
+	}
+	r.Leave()
+	v.Arguments = slice6
+	r.Leave()
+}
+
+func (v TransferObjects) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *TransferObjects) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.Objects))
+	for i1 := range v.Objects {
+		if b, err = v.Objects[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	if b, err = v.Address.AppendBCS(b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *TransferObjects) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *TransferObjects) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*Argument, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(Argument)
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Objects = slice2
+	if v.Address == nil {
+		v.Address = new(Argument)
+	}
+	v.Address.DecodeBCS(r)
+	r.Leave()
+}
+
+func (v SplitCoins) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *SplitCoins) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Coin.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Amount))
+	for i1 := range v.Amount {
+		if b, err = v.Amount[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *SplitCoins) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *SplitCoins) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if v.Coin == nil {
+		v.Coin = new(Argument)
+	}
+	v.Coin.DecodeBCS(r)
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*Argument, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(Argument)
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Amount = slice2
+	r.Leave()
+}
+
+func (v MergeCoins) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *MergeCoins) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Destination.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Sources))
+	for i1 := range v.Sources {
+		if b, err = v.Sources[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *MergeCoins) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *MergeCoins) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if v.Destination == nil {
+		v.Destination = new(Argument)
+	}
+	v.Destination.DecodeBCS(r)
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*Argument, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(Argument)
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Sources = slice2
+	r.Leave()
+}
+
+func (v Publish) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *Publish) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.Modules))
+	for i1 := range v.Modules {
+		b = mystenbcs.AppendULEB128(b, len(v.Modules[i1]))
+		b = append(b, v.Modules[i1]...)
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Dependencies))
+	for i2 := range v.Dependencies {
+		if b, err = v.Dependencies[i2].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *Publish) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *Publish) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([][]byte, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 []byte
+		if data5 := r.ReadBytes(); data5 != nil {
+			elem4 = data5
+		}
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Modules = slice2
+	size6 := r.ReadLen()
+	r.Enter()
+	slice7 := make([]models.SuiAddressBytes, 0, min(size6, mystenbcs.MaxPrealloc))
+	for i8 := 0; i8 < size6 && r.Err() == nil; i8++ {
+		var elem9 models.SuiAddressBytes
+		elem9.DecodeBCS(r)
+		slice7 = append(slice7, elem9)
+	}
+	r.Leave()
+	v.Dependencies = slice7
+	r.Leave()
+}
+
+func (v MakeMoveVec) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *MakeMoveVec) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if v.Type != nil {
+		b = mystenbcs.AppendULEB128(b, len(*v.Type))
+		b = append(b, *v.Type...)
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Elements))
+	for i1 := range v.Elements {
+		if b, err = v.Elements[i1].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
+
+func (v *MakeMoveVec) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *MakeMoveVec) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	if v.Type == nil {
+		v.Type = new(string)
+	}
+	*v.Type = r.ReadString()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*Argument, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(Argument)
+		elem4.DecodeBCS(r)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Elements = slice2
+	r.Leave()
+}
+
+func (v Upgrade) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *Upgrade) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	b = mystenbcs.AppendULEB128(b, len(v.Modules))
+	for i1 := range v.Modules {
+		b = mystenbcs.AppendULEB128(b, len(v.Modules[i1]))
+		b = append(b, v.Modules[i1]...)
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Dependencies))
+	for i2 := range v.Dependencies {
+		if b, err = v.Dependencies[i2].AppendBCS(b); err != nil {
+			return b, err
+		}
+	}
+	if b, err = v.Package.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if b, err = v.Ticket.AppendBCS(b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *Upgrade) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *Upgrade) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([][]byte, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 []byte
+		if data5 := r.ReadBytes(); data5 != nil {
+			elem4 = data5
+		}
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.Modules = slice2
+	size6 := r.ReadLen()
+	r.Enter()
+	slice7 := make([]models.SuiAddressBytes, 0, min(size6, mystenbcs.MaxPrealloc))
+	for i8 := 0; i8 < size6 && r.Err() == nil; i8++ {
+		var elem9 models.SuiAddressBytes
+		elem9.DecodeBCS(r)
+		slice7 = append(slice7, elem9)
+	}
+	r.Leave()
+	v.Dependencies = slice7
+	v.Package.DecodeBCS(r)
+	if v.Ticket == nil {
+		v.Ticket = new(Argument)
+	}
+	v.Ticket.DecodeBCS(r)
+	r.Leave()
+}
+
+func (v Argument) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *Argument) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	switch {
+	case v.GasCoin != nil:
+		b = mystenbcs.AppendULEB128(b, 0)
+		if b, err = mystenbcs.Append(b, v.GasCoin); err != nil {
+			return b, err
+		}
+	case v.Input != nil:
+		b = mystenbcs.AppendULEB128(b, 1)
+		b = binary.LittleEndian.AppendUint16(b, uint16(*v.Input))
+	case v.Result != nil:
+		b = mystenbcs.AppendULEB128(b, 2)
+		b = binary.LittleEndian.AppendUint16(b, uint16(*v.Result))
+	case v.NestedResult != nil:
+		b = mystenbcs.AppendULEB128(b, 3)
+		if b, err = v.NestedResult.AppendBCS(b); err != nil {
+			return b, err
+		}
+	default:
+		return b, mystenbcs.ErrEmptyEnum
+	}
+	return b, nil
+}
+
+func (v *Argument) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *Argument) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	switch index1 := r.ReadVariant(); index1 {
+	case 0:
+		if v.GasCoin == nil {
+			v.GasCoin = struct{}{}
+		} else {
+			r.Decode(&v.GasCoin)
+		}
+	case 1:
+		if v.Input == nil {
+			v.Input = new(uint16)
+		}
+		*v.Input = r.ReadUint16()
+	case 2:
+		if v.Result == nil {
+			v.Result = new(uint16)
+		}
+		*v.Result = r.ReadUint16()
+	case 3:
+		if v.NestedResult == nil {
+			v.NestedResult = new(NestedResult)
+		}
+		v.NestedResult.DecodeBCS(r)
+	default:
+		r.FailUnknownVariant(index1, "transaction.Argument")
+	}
+	r.Leave()
+}
+
+func (v NestedResult) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *NestedResult) AppendBCS(b []byte) ([]byte, error) {
+	b = binary.LittleEndian.AppendUint16(b, uint16(v.Index))
+	b = binary.LittleEndian.AppendUint16(b, uint16(v.ResultIndex))
+	return b, nil
+}
+
+func (v *NestedResult) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *NestedResult) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.Index = r.ReadUint16()
+	v.ResultIndex = r.ReadUint16()
+	r.Leave()
+}
+
+func (v SuiObjectRef) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *SuiObjectRef) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.ObjectId.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = binary.LittleEndian.AppendUint64(b, uint64(v.Version))
+	if b, err = v.Digest.AppendBCS(b); err != nil {
+		return b, err
+	}
+	return b, nil
+}
+
+func (v *SuiObjectRef) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *SuiObjectRef) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.ObjectId.DecodeBCS(r)
+	v.Version = r.ReadUint64()
+	v.Digest.DecodeBCS(r)
+	r.Leave()
+}
+
+func (v SharedObjectRef) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *SharedObjectRef) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.ObjectId.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = binary.LittleEndian.AppendUint64(b, uint64(v.InitialSharedVersion))
+	if v.Mutable {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	return b, nil
+}
+
+func (v *SharedObjectRef) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *SharedObjectRef) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.ObjectId.DecodeBCS(r)
+	v.InitialSharedVersion = r.ReadUint64()
+	v.Mutable = r.ReadBool()
+	r.Leave()
+}
+
+func (v StructTag) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *StructTag) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Address.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.Module))
+	b = append(b, v.Module...)
+	b = mystenbcs.AppendULEB128(b, len(v.Name))
+	b = append(b, v.Name...)
+	b = mystenbcs.AppendULEB128(b, len(v.TypeParams))
+	for i1 := range v.TypeParams {
+		data2, err := v.TypeParams[i1].MarshalBCS()
+		if err != nil {
+			return b, err
+		}
+		b = append(b, data2...)
+	}
+	return b, nil
+}
+
+func (v *StructTag) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *StructTag) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.Address.DecodeBCS(r)
+	v.Module = r.ReadString()
+	v.Name = r.ReadString()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]*TypeTag, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		elem4 := new(TypeTag)
+		r.Unmarshal(elem4)
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.TypeParams = slice2
+	r.Leave()
+}
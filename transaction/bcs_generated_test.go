@@ -0,0 +1,110 @@
+package transaction
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcstest"
+)
+
+func TestGeneratedBcs(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(testing.TB, *rand.Rand, int)
+	}{
+		{name: "TransactionData", check: bcstest.Check[TransactionData]},
+		{name: "TransactionDataV1", check: bcstest.Check[TransactionDataV1]},
+		{name: "GasData", check: bcstest.Check[GasData]},
+		{name: "ProgrammableTransaction", check: bcstest.Check[ProgrammableTransaction]},
+		{name: "TransactionKind", check: bcstest.Check[TransactionKind]},
+		{name: "CallArg", check: bcstest.Check[CallArg]},
+		{name: "Pure", check: bcstest.Check[Pure]},
+		{name: "UnresolvedPure", check: bcstest.Check[UnresolvedPure]},
+		{name: "UnresolvedObject", check: bcstest.Check[UnresolvedObject]},
+		{name: "ObjectArg", check: bcstest.Check[ObjectArg]},
+		{name: "Command", check: bcstest.Check[Command]},
+		{name: "ProgrammableMoveCall", check: bcstest.Check[ProgrammableMoveCall]},
+		{name: "TransferObjects", check: bcstest.Check[TransferObjects]},
+		{name: "SplitCoins", check: bcstest.Check[SplitCoins]},
+		{name: "MergeCoins", check: bcstest.Check[MergeCoins]},
+		{name: "Publish", check: bcstest.Check[Publish]},
+		{name: "MakeMoveVec", check: bcstest.Check[MakeMoveVec]},
+		{name: "Upgrade", check: bcstest.Check[Upgrade]},
+		{name: "Argument", check: bcstest.Check[Argument]},
+		{name: "NestedResult", check: bcstest.Check[NestedResult]},
+		{name: "SuiObjectRef", check: bcstest.Check[SuiObjectRef]},
+		{name: "SharedObjectRef", check: bcstest.Check[SharedObjectRef]},
+		{name: "StructTag", check: bcstest.Check[StructTag]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, rand.New(rand.NewSource(1)), 500)
+		})
+	}
+}
+
+func BenchmarkTransactionDataBcs(b *testing.B) {
+	data, err := base64.StdEncoding.DecodeString(setupTransactionBase64(b))
+	require.NoError(b, err)
+
+	b.Run("generated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var txData TransactionData
+			_, err := mystenbcs.Unmarshal(data, &txData)
+			require.NoError(b, err)
+			_, err = mystenbcs.Marshal(&txData)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("reflective", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var txData TransactionData
+			_, err := mystenbcs.UnmarshalReflect(data, &txData, nil)
+			require.NoError(b, err)
+			_, err = mystenbcs.MarshalReflect(&txData)
+			require.NoError(b, err)
+		}
+	})
+}
+
+// FuzzTransactionData decodes untrusted transaction data within limits, the generated and the
+// reflective decoding must agree and the decoded value must encode back to itself.
+func FuzzTransactionData(f *testing.F) {
+	data, err := base64.StdEncoding.DecodeString(setupTransactionBase64(f))
+	require.NoError(f, err)
+	f.Add(data)
+	f.Add(data[:len(data)/2])
+	options := &mystenbcs.DecodeOptions{MaxBytes: 1 << 16, MaxSequenceLength: 1 << 10, MaxDepth: 32, RejectTrailingBytes: true}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var generated, reflected TransactionData
+		generatedN, generatedErr := mystenbcs.UnmarshalWithOptions(data, &generated, options)
+		reflectedN, reflectedErr := mystenbcs.UnmarshalReflect(data, &reflected, options)
+		require.Equal(t, generatedErr == nil, reflectedErr == nil, "generated error %v, reflective error %v", generatedErr, reflectedErr)
+		if generatedErr != nil {
+			return
+		}
+		require.Equal(t, reflectedN, generatedN)
+		require.Equal(t, reflected, generated)
+
+		encoded, err := mystenbcs.Marshal(&generated)
+		require.NoError(t, err)
+		var decoded TransactionData
+		_, err = mystenbcs.UnmarshalWithOptions(encoded, &decoded, options)
+		require.NoError(t, err)
+		require.Equal(t, generated, decoded)
+	})
+}
+
+func setupTransactionBase64(b testing.TB) string {
+	tx := setupTransaction()
+	splitCoin := tx.SplitCoins(tx.Gas(), []Argument{tx.Pure(uint64(1000))})
+	tx.TransferObjects([]Argument{splitCoin}, tx.Pure("0x9"))
+	data, err := tx.Data.Marshal()
+	require.NoError(b, err)
+	return base64.StdEncoding.EncodeToString(data)
+}
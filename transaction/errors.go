@@ -10,4 +10,5 @@ var (
 	ErrInvalidSuiAddress    = errors.New("invalid sui address")
 	ErrInvalidObjectId      = errors.New("invalid object id")
 	ErrObjectNotSupportType = errors.New("object not support type")
+	ErrEmptyTypeTag         = errors.New("empty type tag")
 )
@@ -9,7 +9,6 @@ import (
 	"github.com/block-vision/sui-go-sdk/constant"
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
-	"github.com/block-vision/sui-go-sdk/signer"
 	"github.com/block-vision/sui-go-sdk/sui"
 	"github.com/block-vision/sui-go-sdk/utils"
 	"github.com/jinzhu/copier"
@@ -18,8 +17,8 @@ import (
 
 type Transaction struct {
 	Data            TransactionData
-	Signer          *signer.Signer
-	SponsoredSigner *signer.Signer
+	Signer          models.Signer
+	SponsoredSigner models.Signer
 	SuiClient       *sui.Client
 }
 
@@ -37,13 +36,14 @@ func NewTransaction() *Transaction {
 	}
 }
 
-func (tx *Transaction) SetSigner(signer *signer.Signer) *Transaction {
+// SetSigner sets the signer of the sender, e.g. a *signer.Signer or a KMS backed implementation.
+func (tx *Transaction) SetSigner(signer models.Signer) *Transaction {
 	tx.Signer = signer
 
 	return tx
 }
 
-func (tx *Transaction) SetSponsoredSigner(signer *signer.Signer) *Transaction {
+func (tx *Transaction) SetSponsoredSigner(signer models.Signer) *Transaction {
 	tx.SponsoredSigner = signer
 
 	return tx
@@ -352,19 +352,23 @@ func (tx *Transaction) ToSuiExecuteTransactionBlockRequest(
 	if err != nil {
 		return nil, err
 	}
+	txBytes, err := mystenbcs.FromBase64(b64TxBytes)
+	if err != nil {
+		return nil, err
+	}
 	var signatures []string
 	if tx.SponsoredSigner != nil {
-		sponsoredMessage, err := tx.SponsoredSigner.SignMessage(b64TxBytes, constant.TransactionDataIntentScope)
+		sponsoredSignature, err := models.SignWithIntent(ctx, tx.SponsoredSigner, txBytes, constant.TransactionDataIntentScope)
 		if err != nil {
 			return nil, err
 		}
-		signatures = append(signatures, sponsoredMessage.Signature)
+		signatures = append(signatures, sponsoredSignature)
 	}
-	message, err := tx.Signer.SignMessage(b64TxBytes, constant.TransactionDataIntentScope)
+	signature, err := models.SignWithIntent(ctx, tx.Signer, txBytes, constant.TransactionDataIntentScope)
 	if err != nil {
 		return nil, err
 	}
-	signatures = append(signatures, message.Signature)
+	signatures = append(signatures, signature)
 
 	return &models.SuiExecuteTransactionBlockRequest{
 		TxBytes:     b64TxBytes,
@@ -389,7 +393,7 @@ func (tx *Transaction) buildTransaction(ctx context.Context) (string, error) {
 		}
 	}
 	tx.SetGasBudgetIfNotSet(defaultGasBudget)
-	tx.SetSenderIfNotSet(models.SuiAddress(tx.Signer.Address))
+	tx.SetSenderIfNotSet(models.SuiAddress(tx.Signer.ToSuiAddress()))
 
 	return tx.build(false)
 }
@@ -408,7 +412,7 @@ func (tx *Transaction) build(onlyTransactionKind bool) (string, error) {
 		return "", ErrSenderNotSet
 	}
 	if tx.Data.V1.GasData.Owner == nil {
-		tx.SetGasOwner(models.SuiAddress(tx.Signer.Address))
+		tx.SetGasOwner(models.SuiAddress(tx.Signer.ToSuiAddress()))
 	}
 	if !tx.Data.V1.GasData.IsAllSet() {
 		return "", ErrGasDataNotAllSet
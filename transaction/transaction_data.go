@@ -1,12 +1,17 @@
 package transaction
 
+//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+
 import (
 	"bytes"
+	"fmt"
+	"io"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 )
 
+//bcs:generate
 type TransactionData struct {
 	V1 *TransactionDataV1
 }
@@ -25,6 +30,8 @@ func (td *TransactionData) Marshal() ([]byte, error) {
 }
 
 // TransactionDataV1 https://github.com/MystenLabs/sui/blob/fb27c6c7166f5e4279d5fd1b2ebc5580ca0e81b2/crates/sui-types/src/transaction.rs#L1625
+//
+//bcs:generate
 type TransactionDataV1 struct {
 	Kind       *TransactionKind
 	Sender     *models.SuiAddressBytes
@@ -86,6 +93,8 @@ func (td *TransactionDataV1) GetInputObjectIndex(address models.SuiAddress) *uin
 }
 
 // GasData https://github.com/MystenLabs/sui/blob/fb27c6c7166f5e4279d5fd1b2ebc5580ca0e81b2/crates/sui-types/src/transaction.rs#L1600
+//
+//bcs:generate
 type GasData struct {
 	Payment *[]SuiObjectRef
 	Owner   *models.SuiAddressBytes
@@ -104,6 +113,8 @@ func (gd *GasData) IsAllSet() bool {
 // TransactionExpiration https://github.com/MystenLabs/sui/blob/fb27c6c7166f5e4279d5fd1b2ebc5580ca0e81b2/crates/sui-types/src/transaction.rs#L1608
 // - None
 // - Epoch
+//
+// TransactionDataV1 encodes it as a struct in an optional field, which has the bytes of the enum.
 type TransactionExpiration struct {
 	None  any
 	Epoch *uint64
@@ -112,6 +123,8 @@ type TransactionExpiration struct {
 func (*TransactionExpiration) IsBcsEnum() {}
 
 // ProgrammableTransaction https://github.com/MystenLabs/sui/blob/fb27c6c7166f5e4279d5fd1b2ebc5580ca0e81b2/crates/sui-types/src/transaction.rs#L702
+//
+//bcs:generate
 type ProgrammableTransaction struct {
 	Inputs   []*CallArg
 	Commands []*Command
@@ -122,6 +135,8 @@ type ProgrammableTransaction struct {
 // - ChangeEpoch
 // - Genesis
 // - ConsensusCommitPrologue
+//
+//bcs:generate
 type TransactionKind struct {
 	ProgrammableTransaction *ProgrammableTransaction
 	ChangeEpoch             any
@@ -147,6 +162,8 @@ func (tk *TransactionKind) Marshal() ([]byte, error) {
 // - Object
 // - UnresolvedPure
 // - UnresolvedObject
+//
+//bcs:generate
 type CallArg struct {
 	Pure             *Pure
 	Object           *ObjectArg
@@ -156,14 +173,17 @@ type CallArg struct {
 
 func (*CallArg) IsBcsEnum() {}
 
+//bcs:generate
 type Pure struct {
 	Bytes []byte
 }
 
+//bcs:generate
 type UnresolvedPure struct {
 	Value any
 }
 
+//bcs:generate
 type UnresolvedObject struct {
 	ObjectId models.SuiAddressBytes
 	// Version
@@ -175,6 +195,8 @@ type UnresolvedObject struct {
 // - ImmOrOwnedObject
 // - SharedObject
 // - Receiving
+//
+//bcs:generate
 type ObjectArg struct {
 	ImmOrOwnedObject *SuiObjectRef
 	SharedObject     *SharedObjectRef
@@ -191,6 +213,8 @@ func (ObjectArg) IsBcsEnum() {}
 // - Publish
 // - MakeMoveVec
 // - Upgrade
+//
+//bcs:generate
 type Command struct {
 	MoveCall        *ProgrammableMoveCall
 	TransferObjects *TransferObjects
@@ -204,6 +228,8 @@ type Command struct {
 func (*Command) IsBcsEnum() {}
 
 // ProgrammableMoveCall https://github.com/MystenLabs/sui/blob/fb27c6c7166f5e4279d5fd1b2ebc5580ca0e81b2/crates/sui-types/src/transaction.rs#L762
+//
+//bcs:generate
 type ProgrammableMoveCall struct {
 	Package       models.SuiAddressBytes
 	Module        string
@@ -212,31 +238,37 @@ type ProgrammableMoveCall struct {
 	Arguments     []*Argument
 }
 
+//bcs:generate
 type TransferObjects struct {
 	Objects []*Argument
 	Address *Argument
 }
 
+//bcs:generate
 type SplitCoins struct {
 	Coin   *Argument
 	Amount []*Argument
 }
 
+//bcs:generate
 type MergeCoins struct {
 	Destination *Argument
 	Sources     []*Argument
 }
 
+//bcs:generate
 type Publish struct {
 	Modules      [][]byte
 	Dependencies []models.SuiAddressBytes
 }
 
+//bcs:generate
 type MakeMoveVec struct {
 	Type     *string
 	Elements []*Argument
 }
 
+//bcs:generate
 type Upgrade struct {
 	Modules      [][]byte
 	Dependencies []models.SuiAddressBytes
@@ -249,6 +281,8 @@ type Upgrade struct {
 // - Input
 // - Result
 // - NestedResult
+//
+//bcs:generate
 type Argument struct {
 	GasCoin      any
 	Input        *uint16
@@ -258,23 +292,27 @@ type Argument struct {
 
 func (*Argument) IsBcsEnum() {}
 
+//bcs:generate
 type NestedResult struct {
 	Index       uint16
 	ResultIndex uint16
 }
 
+//bcs:generate
 type SuiObjectRef struct {
 	ObjectId models.SuiAddressBytes
 	Version  uint64
 	Digest   models.ObjectDigestBytes
 }
 
+//bcs:generate
 type SharedObjectRef struct {
 	ObjectId             models.SuiAddressBytes
 	InitialSharedVersion uint64
 	Mutable              bool
 }
 
+//bcs:generate
 type StructTag struct {
 	Address    models.SuiAddressBytes
 	Module     string
@@ -283,7 +321,7 @@ type StructTag struct {
 }
 
 // TypeTag https://github.com/MystenLabs/sui/blob/ece197ed5c414eb274f99afc52704664af8d0c38/external-crates/move/crates/move-core-types/src/language_storage.rs#L33
-// Do not reorder the fields, it will break the bcs encoding
+// The variant indexes are set by MarshalBCS and UnmarshalBCS, not by the order of the fields.
 type TypeTag struct {
 	Bool    *bool
 	U8      *bool
@@ -299,3 +337,82 @@ type TypeTag struct {
 }
 
 func (*TypeTag) IsBcsEnum() {}
+
+// MarshalBCS encodes the type tag, the *bool of a primitive variant only marks the variant and
+// is not encoded.
+func (t TypeTag) MarshalBCS() ([]byte, error) {
+	switch {
+	case t.Bool != nil:
+		return mystenbcs.ULEB128Encode(0), nil
+	case t.U8 != nil:
+		return mystenbcs.ULEB128Encode(1), nil
+	case t.U128 != nil:
+		return mystenbcs.ULEB128Encode(2), nil
+	case t.U256 != nil:
+		return mystenbcs.ULEB128Encode(3), nil
+	case t.Address != nil:
+		return mystenbcs.ULEB128Encode(4), nil
+	case t.Signer != nil:
+		return mystenbcs.ULEB128Encode(5), nil
+	case t.Vector != nil:
+		elem, err := t.Vector.MarshalBCS()
+		if err != nil {
+			return nil, err
+		}
+		return append(mystenbcs.ULEB128Encode(6), elem...), nil
+	case t.Struct != nil:
+		structTag, err := mystenbcs.Marshal(t.Struct)
+		if err != nil {
+			return nil, err
+		}
+		return append(mystenbcs.ULEB128Encode(7), structTag...), nil
+	case t.U16 != nil:
+		return mystenbcs.ULEB128Encode(8), nil
+	case t.U32 != nil:
+		return mystenbcs.ULEB128Encode(9), nil
+	case t.U64 != nil:
+		return mystenbcs.ULEB128Encode(10), nil
+	default:
+		return nil, ErrEmptyTypeTag
+	}
+}
+
+func (t *TypeTag) UnmarshalBCS(r io.Reader) (int, error) {
+	variant, n, err := mystenbcs.ULEB128Decode[int](r)
+	if err != nil {
+		return n, err
+	}
+	marker := true
+	switch variant {
+	case 0:
+		t.Bool = &marker
+	case 1:
+		t.U8 = &marker
+	case 2:
+		t.U128 = &marker
+	case 3:
+		t.U256 = &marker
+	case 4:
+		t.Address = &marker
+	case 5:
+		t.Signer = &marker
+	case 6:
+		// decoded by the decoder calling it, whose depth limit bounds the nesting of vectors
+		t.Vector = &TypeTag{}
+		k, err := mystenbcs.NewDecoder(r).Decode(t.Vector)
+		return n + k, err
+	case 7:
+		t.Struct = &StructTag{}
+		k, err := mystenbcs.NewDecoder(r).Decode(t.Struct)
+		return n + k, err
+	case 8:
+		t.U16 = &marker
+	case 9:
+		t.U32 = &marker
+	case 10:
+		t.U64 = &marker
+	default:
+		return n, fmt.Errorf("unknown type tag variant %d", variant)
+	}
+	return n, nil
+}
@@ -21,3 +21,41 @@ func TestBcsUnmarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txBz, genBz)
 }
+
+func TestTypeTagBcs(t *testing.T) {
+	marker := true
+	tests := []struct {
+		name    string
+		typeTag TypeTag
+		want    []byte
+	}{
+		{name: "u64", typeTag: TypeTag{U64: &marker}, want: []byte{10}},
+		{name: "vector<u8>", typeTag: TypeTag{Vector: &TypeTag{U8: &marker}}, want: []byte{6, 1}},
+		{
+			name: "0x2::coin::Coin<u64>",
+			typeTag: TypeTag{Struct: &StructTag{
+				Address:    [32]byte{31: 2},
+				Module:     "coin",
+				Name:       "Coin",
+				TypeParams: []*TypeTag{{U64: &marker}},
+			}},
+			want: append(append([]byte{7}, append(make([]byte, 31), 2)...), 4, 'c', 'o', 'i', 'n', 4, 'C', 'o', 'i', 'n', 1, 10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := mystenbcs.Marshal(&tt.typeTag)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, data)
+
+			var decoded TypeTag
+			n, err := mystenbcs.Unmarshal(data, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, len(data), n)
+			require.Equal(t, tt.typeTag, decoded)
+		})
+	}
+
+	_, err := mystenbcs.Marshal(&TypeTag{})
+	require.ErrorIs(t, err, ErrEmptyTypeTag)
+}
@@ -1,8 +1,27 @@
 package verify
 
-import "github.com/machinebox/graphql"
+import (
+	"github.com/machinebox/graphql"
 
-type IPublicKey interface {
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+)
+
+// PublicKey is implemented by the public keys of every signature scheme.
+type PublicKey interface {
+	Scheme() scheme.SignatureScheme
+	Flag() byte
+	// ToRawBytes returns the public key without its flag.
+	ToRawBytes() []byte
+	// ToSuiBytes returns `flag || pubkey`, the bytes hashed with blake2b into the Sui address.
+	ToSuiBytes() []byte
+	// ToSuiPublicKey returns the base64 ToSuiBytes, the form used by the Sui CLI and RPC.
+	ToSuiPublicKey() string
 	ToSuiAddress() string
 	VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error)
+	VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error)
+	VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error)
 }
+
+// IPublicKey is the former name of PublicKey.
+type IPublicKey = PublicKey
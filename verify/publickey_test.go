@@ -0,0 +1,77 @@
+package verify
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/multisig"
+	"github.com/block-vision/sui-go-sdk/signer"
+)
+
+func TestPublicKeyFromBase64(t *testing.T) {
+	k1Signer, err := signer.NewSecp256k1SignerWithMnemonic("film crazy soon outside stand loop subway crumble thrive popular green nuclear struggle pistol arm wife phrase warfare march wheat nephew ask sunny firm")
+	require.NoError(t, err)
+	r1Signer, err := signer.NewSecp256r1SignerWithMnemonic("act wing dilemma glory episode region allow mad tourist humble muffin oblige")
+	require.NoError(t, err)
+	edSignature, err := models.FromSerializedSignature("AIjj13rXd9GFZRNPd4XNUvthHMHg5bovf8/mW4a7EYAWC6mQtAAaa0tSPhk6YpNED34/qeaCYwnN1QAsKm253gfQ6i6fULpM+uscFuJIXoTT/JQvMo3CUlLODcGxPkUbHg==")
+	require.NoError(t, err)
+
+	multiSigPublicKey, err := multisig.NewMultiSigPublicKeyFromPublicKeys([]multisig.PubkeyWeightPair{
+		{SignatureScheme: scheme.Secp256k1, PubKey: k1Signer.GetPublicKey(), Weight: 1},
+		{SignatureScheme: scheme.Secp256r1, PubKey: r1Signer.GetPublicKey(), Weight: 1},
+	}, 2, nil)
+	require.NoError(t, err)
+
+	zkLoginIdentifier := append([]byte{byte(len("https://accounts.google.com"))}, "https://accounts.google.com"...)
+	zkLoginIdentifier = append(zkLoginIdentifier, make([]byte, 32)...)
+
+	// The ed25519, secp256k1 and secp256r1 addresses are those of the TS SDK keypair tests. The
+	// others were computed apart from the SDK, as the blake2b of `flag || pubkey` for the passkey,
+	// of `flag || threshold || flag || pubkey || weight...` for the multisig and of
+	// `flag || len(iss) || iss || address_seed` for the zkLogin identifier.
+	tests := []struct {
+		name        string
+		scheme      scheme.SignatureScheme
+		raw         []byte
+		wantAddress string
+	}{
+		{name: "ed25519", scheme: scheme.ED25519, raw: edSignature.PubKey, wantAddress: "0x00dccd645260cfe9145bdabb7b45b42e188af8661086aa7bb2e7f3adc1cd2785"},
+		{name: "secp256k1", scheme: scheme.Secp256k1, raw: k1Signer.GetPublicKey(), wantAddress: "0x9e8f732575cc5386f8df3c784cd3ed1b53ce538da79926b2ad54dcc1197d2532"},
+		{name: "secp256r1", scheme: scheme.Secp256r1, raw: r1Signer.GetPublicKey(), wantAddress: "0x4a822457f1970468d38dae8e63fb60eefdaa497d74d781f581ea2d137ec36f3a"},
+		{name: "passkey", scheme: scheme.Passkey, raw: r1Signer.GetPublicKey(), wantAddress: "0x54a312e953813126c5176c6a4118d180ea118a12d2b4d6f122d5b3f56b105659"},
+		{name: "multisig", scheme: scheme.MultiSig, raw: multiSigPublicKey.ToRawBytes(), wantAddress: "0x997b82eb370a3b08d681e08bfc45495c39a636d846c300285f0eee2a482a7cba"},
+		{name: "zklogin", scheme: scheme.ZkLogin, raw: zkLoginIdentifier, wantAddress: "0x089ef22e71af402ed76c56feef77bd283beb700a2f9878256b03fa499540f329"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publicKey, err := PublicKeyFromRawBytes(tt.scheme, tt.raw, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.scheme, publicKey.Scheme())
+			require.Equal(t, scheme.SignatureSchemeToFlag[tt.scheme], publicKey.Flag())
+			require.Equal(t, tt.raw, publicKey.ToRawBytes())
+			require.Equal(t, append([]byte{publicKey.Flag()}, tt.raw...), publicKey.ToSuiBytes())
+			require.Equal(t, tt.wantAddress, publicKey.ToSuiAddress())
+
+			parsed, err := PublicKeyFromBase64(publicKey.ToSuiPublicKey(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.scheme, parsed.Scheme())
+			require.Equal(t, tt.wantAddress, parsed.ToSuiAddress())
+		})
+	}
+
+	for _, value := range []string{
+		"",
+		"not base64",
+		base64.StdEncoding.EncodeToString([]byte{0x04, 0x01}),
+		base64.StdEncoding.EncodeToString(append([]byte{0x00}, make([]byte, 31)...)),
+		base64.StdEncoding.EncodeToString(append([]byte{0x01}, make([]byte, 32)...)),
+		base64.StdEncoding.EncodeToString([]byte{0x05}),
+	} {
+		_, err := PublicKeyFromBase64(value, nil)
+		require.Error(t, err, value)
+	}
+}
@@ -1,45 +1,192 @@
 package verify
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 
+	"github.com/machinebox/graphql"
+
 	"github.com/block-vision/sui-go-sdk/cryptography"
 	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
 	"github.com/block-vision/sui-go-sdk/keypairs/ed25519"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256k1"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/multisig"
+	"github.com/block-vision/sui-go-sdk/passkey"
+	"github.com/block-vision/sui-go-sdk/utils"
 	"github.com/block-vision/sui-go-sdk/zklogin"
 )
 
-func VerifyPersonalMessageSignature(message []byte, signature []byte, options *zklogin.ZkLoginPublicIdentifierOptions) (signer string, pass bool, err error) {
-	parsedSignature := parseSignature(signature, options)
+var ErrSignerMismatch = errors.New("signature was not signed by the expected address")
+
+var (
+	_ PublicKey = (*ed25519.Ed25519PublicKey)(nil)
+	_ PublicKey = (*secp256k1.Secp256k1PublicKey)(nil)
+	_ PublicKey = (*secp256r1.Secp256r1PublicKey)(nil)
+	_ PublicKey = (*multisig.MultiSigPublicKey)(nil)
+	_ PublicKey = (*zklogin.ZkLoginPublicIdentifier)(nil)
+	_ PublicKey = (*passkey.PasskeyPublicKey)(nil)
+)
+
+type VerifyOptions struct {
+	// Address is the expected signer, it is not checked when empty.
+	Address string
+	// Client is used to verify zkLogin signatures through GraphQL when ZkLoginParams is not set.
+	Client *graphql.Client
+	// ZkLoginParams verifies zkLogin signatures offline.
+	ZkLoginParams *zklogin.VerifyParams
+}
+
+// VerifyPersonalMessageSignature verifies a base64 serialized signature of any scheme over the
+// personal message, and returns the address of the signer.
+func VerifyPersonalMessageSignature(message []byte, signature string, options *VerifyOptions) (signer string, pass bool, err error) {
+	parsedSignature, publicKey, err := parseSignature(signature, options)
+	if err != nil {
+		return "", false, err
+	}
+
+	pass, err = publicKey.VerifyPersonalMessage(message, parsedSignature.Signature, options.client())
+	if err != nil || !pass {
+		return "", false, err
+	}
+
+	return checkSigner(publicKey, options)
+}
 
-	publicKey, err := publicKeyFromRawBytes(parsedSignature.SignatureScheme, parsedSignature.PubKey, options)
+// VerifyTransactionSignature verifies a base64 serialized signature of any scheme over the
+// transaction data bytes, and returns the address of the signer.
+func VerifyTransactionSignature(txBytes []byte, signature string, options *VerifyOptions) (signer string, pass bool, err error) {
+	parsedSignature, publicKey, err := parseSignature(signature, options)
 	if err != nil {
 		return "", false, err
 	}
 
-	pass, err = publicKey.VerifyPersonalMessage(message, parsedSignature.Signature, options.Client)
+	pass, err = publicKey.VerifyTransaction(txBytes, parsedSignature.Signature, options.client())
+	if err != nil || !pass {
+		return "", false, err
+	}
+
+	return checkSigner(publicKey, options)
+}
+
+// VerifyIntentMessageSignature verifies a base64 serialized signature of any scheme over the
+// intent message, and returns the address of the signer. zkLogin signatures are verified offline
+// only, so ZkLoginParams must be set for them.
+func VerifyIntentMessageSignature(message models.IntentMessage, signature string, options *VerifyOptions) (signer string, pass bool, err error) {
+	parsedSignature, publicKey, err := parseSignature(signature, options)
 	if err != nil {
 		return "", false, err
 	}
 
+	pass, err = publicKey.VerifyWithIntent(message.Value, parsedSignature.Signature, message.Intent.Scope)
+	if err != nil || !pass {
+		return "", false, err
+	}
+
+	return checkSigner(publicKey, options)
+}
+
+func checkSigner(publicKey PublicKey, options *VerifyOptions) (string, bool, error) {
 	address := publicKey.ToSuiAddress()
+	if options != nil && options.Address != "" && string(utils.NormalizeSuiAddress(options.Address)) != address {
+		return "", false, ErrSignerMismatch
+	}
 
 	return address, true, nil
 }
 
-func parseSignature(signature []byte, options *zklogin.ZkLoginPublicIdentifierOptions) *cryptography.SignaturePubkeyPair {
-	return nil
+func parseSignature(signature string, options *VerifyOptions) (*cryptography.SignaturePubkeyPair, PublicKey, error) {
+	parsedSignature, err := cryptography.ParseSerializedSignature(signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := PublicKeyFromRawBytes(parsedSignature.SignatureScheme, parsedSignature.PubKey, options.zkLoginOptions())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parsedSignature, publicKey, nil
 }
 
-// publicKeyFromRawBytes function in Go
-func publicKeyFromRawBytes(signatureScheme scheme.SignatureScheme, bytes []byte, options *zklogin.ZkLoginPublicIdentifierOptions) (IPublicKey, error) {
+// PublicKeyFromRawBytes creates the public key of the signature scheme from its raw bytes.
+func PublicKeyFromRawBytes(signatureScheme scheme.SignatureScheme, bytes []byte, options *zklogin.ZkLoginPublicIdentifierOptions) (PublicKey, error) {
 	switch signatureScheme {
 	case scheme.ED25519:
+		if len(bytes) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 public key size: %d", len(bytes))
+		}
 		return ed25519.NewEd25519PublicKey(bytes), nil
+	case scheme.Secp256k1:
+		if len(bytes) != secp256k1.PublicKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 public key size: %d", len(bytes))
+		}
+		return secp256k1.NewSecp256k1PublicKey(bytes), nil
+	case scheme.Secp256r1:
+		if len(bytes) != secp256r1.PublicKeySize {
+			return nil, fmt.Errorf("invalid secp256r1 public key size: %d", len(bytes))
+		}
+		return secp256r1.NewSecp256r1PublicKey(bytes), nil
+	case scheme.MultiSig:
+		publicKey, err := multisig.NewMultiSigPublicKey(bytes, options)
+		if err != nil {
+			return nil, err
+		}
+		return publicKey, nil
 	case scheme.ZkLogin:
+		if len(bytes) == 0 {
+			return nil, errors.New("empty zkLogin public identifier")
+		}
 		return zklogin.NewZkLoginPublicIdentifier(bytes, options), nil
+	case scheme.Passkey:
+		if len(bytes) != secp256r1.PublicKeySize {
+			return nil, fmt.Errorf("invalid passkey public key size: %d", len(bytes))
+		}
+		return passkey.NewPasskeyPublicKey(bytes), nil
 	default:
 		return nil, errors.New(fmt.Sprintf("Unsupported signature scheme %s", signatureScheme))
 	}
 }
+
+// PublicKeyFromSuiBytes creates the public key from `flag || pubkey`.
+func PublicKeyFromSuiBytes(bytes []byte, options *zklogin.ZkLoginPublicIdentifierOptions) (PublicKey, error) {
+	if len(bytes) == 0 {
+		return nil, errors.New("empty public key")
+	}
+	signatureScheme, ok := scheme.SignatureFlagToScheme[bytes[0]]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signature flag %d", bytes[0])
+	}
+
+	return PublicKeyFromRawBytes(signatureScheme, bytes[1:], options)
+}
+
+// PublicKeyFromBase64 creates the public key from the base64 `flag || pubkey`, as returned by
+// ToSuiPublicKey and shown by the Sui CLI.
+func PublicKeyFromBase64(value string, options *zklogin.ZkLoginPublicIdentifierOptions) (PublicKey, error) {
+	bytes, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 public key: %v", err)
+	}
+
+	return PublicKeyFromSuiBytes(bytes, options)
+}
+
+func (o *VerifyOptions) client() *graphql.Client {
+	if o == nil {
+		return nil
+	}
+	return o.Client
+}
+
+func (o *VerifyOptions) zkLoginOptions() *zklogin.ZkLoginPublicIdentifierOptions {
+	if o == nil {
+		return nil
+	}
+	return &zklogin.ZkLoginPublicIdentifierOptions{
+		Client: o.Client,
+		Params: o.ZkLoginParams,
+	}
+}
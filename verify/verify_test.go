@@ -0,0 +1,353 @@
+package verify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/multisig"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/passkey"
+	"github.com/block-vision/sui-go-sdk/signer"
+	"github.com/block-vision/sui-go-sdk/zklogin"
+)
+
+type testSigner struct {
+	flag   byte
+	pubKey []byte
+	sign   func(digest []byte) []byte
+}
+
+func (s testSigner) serialize(signature []byte) string {
+	serialized := append([]byte{s.flag}, signature...)
+	return base64.StdEncoding.EncodeToString(append(serialized, s.pubKey...))
+}
+
+func newEd25519Signer(t *testing.T) testSigner {
+	pubKey, priKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return testSigner{flag: 0x00, pubKey: pubKey, sign: func(digest []byte) []byte {
+		return ed25519.Sign(priKey, digest)
+	}}
+}
+
+func newSecp256k1Signer(t *testing.T) testSigner {
+	priKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	return testSigner{flag: 0x01, pubKey: priKey.PubKey().SerializeCompressed(), sign: func(digest []byte) []byte {
+		hash := sha256.Sum256(digest)
+		sig := k1ecdsa.Sign(priKey, hash[:])
+		r, s := sig.R(), sig.S()
+		rBytes, sBytes := r.Bytes(), s.Bytes()
+		return append(rBytes[:], sBytes[:]...)
+	}}
+}
+
+func newSecp256r1Key(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return priKey, elliptic.MarshalCompressed(elliptic.P256(), priKey.X, priKey.Y)
+}
+
+func signSecp256r1(t *testing.T, priKey *ecdsa.PrivateKey, message []byte) []byte {
+	hash := sha256.Sum256(message)
+	r, s, err := ecdsa.Sign(rand.Reader, priKey, hash[:])
+	require.NoError(t, err)
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signature
+}
+
+func newSecp256r1Signer(t *testing.T) testSigner {
+	priKey, pubKey := newSecp256r1Key(t)
+	return testSigner{flag: 0x02, pubKey: pubKey, sign: func(digest []byte) []byte {
+		return signSecp256r1(t, priKey, digest)
+	}}
+}
+
+func personalMessageDigest(t *testing.T, message []byte) []byte {
+	bcsMessage, err := mystenbcs.Marshal(message)
+	require.NoError(t, err)
+	digest := blake2b.Sum256(models.NewMessageWithIntent(bcsMessage, constant.PersonalMessageIntentScope))
+	return digest[:]
+}
+
+func TestVerifyPersonalMessageSignature(t *testing.T) {
+	message := []byte("hello sui")
+
+	for name, newSigner := range map[string]func(*testing.T) testSigner{
+		"ED25519":   newEd25519Signer,
+		"Secp256k1": newSecp256k1Signer,
+		"Secp256r1": newSecp256r1Signer,
+	} {
+		t.Run(name, func(t *testing.T) {
+			signer := newSigner(t)
+			signature := signer.serialize(signer.sign(personalMessageDigest(t, message)))
+			suiBytes := append([]byte{signer.flag}, signer.pubKey...)
+			publicKey, err := PublicKeyFromSuiBytes(suiBytes, nil)
+			require.NoError(t, err)
+
+			address, pass, err := VerifyPersonalMessageSignature(message, signature, nil)
+			require.NoError(t, err)
+			require.True(t, pass)
+			require.Equal(t, publicKey.ToSuiAddress(), address)
+
+			_, pass, err = VerifyPersonalMessageSignature(message, signature, &VerifyOptions{Address: address})
+			require.NoError(t, err)
+			require.True(t, pass)
+
+			_, pass, err = VerifyPersonalMessageSignature(message, signature, &VerifyOptions{Address: "0x2"})
+			require.ErrorIs(t, err, ErrSignerMismatch)
+			require.False(t, pass)
+
+			_, pass, err = VerifyPersonalMessageSignature([]byte("another message"), signature, nil)
+			require.NoError(t, err)
+			require.False(t, pass)
+
+			_, pass, err = VerifyTransactionSignature(message, signature, nil)
+			require.NoError(t, err)
+			require.False(t, pass)
+		})
+	}
+}
+
+func TestVerifyEd25519Vector(t *testing.T) {
+	address, pass, err := VerifyPersonalMessageSignature(
+		[]byte("123456 is the thing that you need to sign"),
+		"AIjj13rXd9GFZRNPd4XNUvthHMHg5bovf8/mW4a7EYAWC6mQtAAaa0tSPhk6YpNED34/qeaCYwnN1QAsKm253gfQ6i6fULpM+uscFuJIXoTT/JQvMo3CUlLODcGxPkUbHg==",
+		nil,
+	)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, "0x00dccd645260cfe9145bdabb7b45b42e188af8661086aa7bb2e7f3adc1cd2785", address)
+}
+
+func TestVerifyTransactionSignatureMultiSig(t *testing.T) {
+	txBytes := []byte("transaction data")
+	digest := blake2b.Sum256(models.NewMessageWithIntent(txBytes, constant.TransactionDataIntentScope))
+
+	ed := newEd25519Signer(t)
+	k1 := newSecp256k1Signer(t)
+	r1 := newSecp256r1Signer(t)
+	var edPk [32]byte
+	var k1Pk, r1Pk [33]byte
+	copy(edPk[:], ed.pubKey)
+	copy(k1Pk[:], k1.pubKey)
+	copy(r1Pk[:], r1.pubKey)
+	multisigPk := multisig.MultiSigPublicKeyStruct{
+		PkMap: []multisig.MultiSigPkMap{
+			{PubKey: multisig.PublicKey{ED25519: &edPk}, Weight: 1},
+			{PubKey: multisig.PublicKey{Secp256k1: &k1Pk}, Weight: 2},
+			{PubKey: multisig.PublicKey{Secp256r1: &r1Pk}, Weight: 3},
+		},
+		Threshold: 3,
+	}
+
+	serialize := func(bitmap uint16, signers ...testSigner) string {
+		sigs := make([]multisig.CompressedSignature, len(signers))
+		for i, signer := range signers {
+			var sig [64]byte
+			copy(sig[:], signer.sign(digest[:]))
+			switch signer.flag {
+			case 0x00:
+				sigs[i].ED25519 = &sig
+			case 0x01:
+				sigs[i].Secp256k1 = &sig
+			case 0x02:
+				sigs[i].Secp256r1 = &sig
+			}
+		}
+		bcsBytes, err := mystenbcs.Marshal(&multisig.MultiSigStruct{Sigs: sigs, Bitmap: bitmap, MultisigPk: multisigPk})
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(append([]byte{0x03}, bcsBytes...))
+	}
+
+	pkBytes, err := mystenbcs.Marshal(&multisigPk)
+	require.NoError(t, err)
+	publicKey, err := multisig.NewMultiSigPublicKey(pkBytes, nil)
+	require.NoError(t, err)
+
+	address, pass, err := VerifyTransactionSignature(txBytes, serialize(0b011, ed, k1), nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, publicKey.ToSuiAddress(), address)
+
+	_, pass, err = VerifyTransactionSignature(txBytes, serialize(0b100, r1), nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	// below threshold
+	_, pass, err = VerifyTransactionSignature(txBytes, serialize(0b010, k1), nil)
+	require.NoError(t, err)
+	require.False(t, pass)
+
+	// signature does not belong to the key in the bitmap
+	_, _, err = VerifyTransactionSignature(txBytes, serialize(0b100, k1), nil)
+	require.Error(t, err)
+}
+
+func TestVerifyPersonalMessageSignaturePasskey(t *testing.T) {
+	message := []byte("hello passkey")
+	priKey, pubKey := newSecp256r1Key(t)
+	digest := personalMessageDigest(t, message)
+
+	clientDataJson, err := json.Marshal(passkey.ClientDataJSON{
+		Type:      "webauthn.get",
+		Challenge: base64.RawURLEncoding.EncodeToString(digest),
+		Origin:    "https://www.sui.io",
+	})
+	require.NoError(t, err)
+	authenticatorData := make([]byte, 37)
+	clientDataHash := sha256.Sum256(clientDataJson)
+	userSignature := append([]byte{0x02}, signSecp256r1(t, priKey, append(authenticatorData, clientDataHash[:]...))...)
+
+	bcsBytes, err := mystenbcs.Marshal(&passkey.PasskeyAuthenticator{
+		AuthenticatorData: authenticatorData,
+		ClientDataJson:    string(clientDataJson),
+		UserSignature:     append(userSignature, pubKey...),
+	})
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(append([]byte{0x06}, bcsBytes...))
+
+	address, pass, err := VerifyPersonalMessageSignature(message, signature, nil)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, passkey.NewPasskeyPublicKey(pubKey).ToSuiAddress(), address)
+
+	_, pass, err = VerifyPersonalMessageSignature([]byte("another message"), signature, nil)
+	require.NoError(t, err)
+	require.False(t, pass)
+}
+
+// newZkLoginVerifyParams builds a verifying key from known scalars and returns the params with a
+// trusted JWK for the Google issuer, along with a prover producing proofs that satisfy the key.
+func newZkLoginVerifyParams(t *testing.T) (*zklogin.VerifyParams, []byte, func(input *big.Int) zklogin.ProofPoints) {
+	_, _, g1, g2 := bn254.Generators()
+	g1Mul := func(s int64) bn254.G1Affine {
+		var p bn254.G1Affine
+		p.ScalarMultiplication(&g1, big.NewInt(s))
+		return p
+	}
+	g2Mul := func(s int64) bn254.G2Affine {
+		var p bn254.G2Affine
+		p.ScalarMultiplication(&g2, big.NewInt(s))
+		return p
+	}
+	fpString := func(toBigInt func(*big.Int) *big.Int) string {
+		return toBigInt(new(big.Int)).String()
+	}
+
+	// alpha = 3, beta = 5, gamma = 7, delta = 11, ic = [13, 17], a = 19, b = 23
+	vk := &zklogin.VerifyingKey{
+		Alpha: g1Mul(3),
+		Beta:  g2Mul(5),
+		Gamma: g2Mul(7),
+		Delta: g2Mul(11),
+		IC:    []bn254.G1Affine{g1Mul(13), g1Mul(17)},
+	}
+	prove := func(input *big.Int) zklogin.ProofPoints {
+		// c = (a*b - alpha*beta - (ic0 + ic1*input)*gamma) / delta
+		r := fr.Modulus()
+		c := new(big.Int).Mul(big.NewInt(17), input)
+		c.Add(c, big.NewInt(13))
+		c.Mul(c, big.NewInt(-7))
+		c.Add(c, big.NewInt(19*23-3*5))
+		c.Mul(c, new(big.Int).ModInverse(big.NewInt(11), r))
+		c.Mod(c, r)
+
+		var pc bn254.G1Affine
+		pc.ScalarMultiplication(&g1, c)
+		pa, pb := g1Mul(19), g2Mul(23)
+		return zklogin.ProofPoints{
+			A: []string{fpString(pa.X.BigInt), fpString(pa.Y.BigInt), "1"},
+			B: [][]string{
+				{fpString(pb.X.A0.BigInt), fpString(pb.X.A1.BigInt)},
+				{fpString(pb.Y.A0.BigInt), fpString(pb.Y.A1.BigInt)},
+				{"1", "0"},
+			},
+			C: []string{fpString(pc.X.BigInt), fpString(pc.Y.BigInt), "1"},
+		}
+	}
+
+	modulus := make([]byte, 256)
+	for i := range modulus {
+		modulus[i] = byte(i + 1)
+	}
+	params := &zklogin.VerifyParams{
+		Jwks: zklogin.JwkSet{
+			{Iss: "https://accounts.google.com", Kid: "test-kid"}: {
+				Kty: "RSA",
+				E:   "AQAB",
+				N:   base64.RawURLEncoding.EncodeToString(modulus),
+				Alg: "RS256",
+			},
+		},
+		CurrentEpoch: 1,
+		VerifyingKey: vk,
+	}
+	return params, modulus, prove
+}
+
+func TestVerifyZkLoginLegacyGoogleIssuer(t *testing.T) {
+	params, modulus, prove := newZkLoginVerifyParams(t)
+	ephemeral := signer.NewSigner(make([]byte, 32))
+	ephemeralPubKey := append([]byte{0x00}, ephemeral.GetPublicKey()...)
+
+	newZkLoginSigner := func(issBase64 string) *zklogin.ZkLoginSigner {
+		inputs := zklogin.ZkLoginSignatureInputs{
+			IssBase64Details: zklogin.IssBase64Details{Value: issBase64, IndexMod4: 1},
+			HeaderBase64:     base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"test-kid","typ":"JWT"}`)),
+			AddressSeed:      "2455937816256448139867034291880826393632839155025573768185017581009163315316",
+		}
+		input, err := inputs.CalculateAllInputsHash(ephemeralPubKey, modulus, 10)
+		require.NoError(t, err)
+		inputs.ProofPoints = prove(input)
+
+		zkSigner, err := zklogin.NewZkLoginSigner(ephemeral, inputs, 10)
+		require.NoError(t, err)
+		return zkSigner
+	}
+
+	// `"iss":"accounts.google.com",` and `"iss":"https://accounts.google.com",` of a JWT payload
+	legacy := newZkLoginSigner("yJpc3MiOiJhY2NvdW50cy5nb29nbGUuY29tIiw")
+	canonical := newZkLoginSigner("yJpc3MiOiJodHRwczovL2FjY291bnRzLmdvb2dsZS5jb20iLC")
+	require.Equal(t, canonical.ToSuiAddress(), legacy.ToSuiAddress())
+
+	options := &VerifyOptions{Address: legacy.ToSuiAddress(), ZkLoginParams: params}
+	message := []byte("hello sui")
+	signature, err := models.SignPersonalMessage(context.Background(), legacy, message)
+	require.NoError(t, err)
+	address, pass, err := VerifyPersonalMessageSignature(message, signature, options)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, legacy.ToSuiAddress(), address)
+
+	txBytes := []byte("transaction data")
+	signature, err = models.SignTransactionData(context.Background(), legacy, txBytes)
+	require.NoError(t, err)
+	address, pass, err = VerifyTransactionSignature(txBytes, signature, options)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, legacy.ToSuiAddress(), address)
+}
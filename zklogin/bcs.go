@@ -1,27 +1,36 @@
 package zklogin
 
+//go:generate go run github.com/block-vision/sui-go-sdk/cmd/bcsgen
+
+// ProofPoints holds the Groth16 proof in the circom/snarkjs projective string format.
+//
+//bcs:generate
 type ProofPoints struct {
-	A []string   `bcs:"a"`
-	B [][]string `bcs:"b"`
-	C []string   `bcs:"c"`
+	A []string
+	B [][]string
+	C []string
 }
 
+//bcs:generate
 type IssBase64Details struct {
-	Value     string `bcs:"value"`
-	IndexMod4 uint8  `bcs:"indexMod4"`
+	Value     string
+	IndexMod4 uint8
 }
 
+//bcs:generate
 type ZkLoginSignatureInputs struct {
-	ProofPoints      ProofPoints      `bcs:"proofPoints"`
-	IssBase64Details IssBase64Details `bcs:"issBase64Details"`
-	HeaderBase64     string           `bcs:"headerBase64"`
-	AddressSeed      string           `bcs:"addressSeed"`
+	ProofPoints      ProofPoints
+	IssBase64Details IssBase64Details
+	HeaderBase64     string
+	AddressSeed      string
 }
 
+//bcs:generate
 type ZkLoginSignature struct {
-	Inputs        ZkLoginSignatureInputs `bcs:"inputs"`
-	MaxEpoch      uint64                 `bcs:"maxEpoch"`
-	UserSignature []byte                 `bcs:"userSignature"`
-	Iss           string                 `bcs:"iss"`
-	AddressSeed   string                 `bcs:"addressSeed"`
+	Inputs        ZkLoginSignatureInputs
+	MaxEpoch      uint64
+	UserSignature []byte
+	// Iss and AddressSeed are not part of the bcs layout, they are filled in after parsing.
+	Iss         string `bcs:"-"`
+	AddressSeed string `bcs:"-"`
 }
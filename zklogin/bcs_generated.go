@@ -0,0 +1,187 @@
+// Code generated by bcsgen. DO NOT EDIT.
+
+package zklogin
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+func (v ProofPoints) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ProofPoints) AppendBCS(b []byte) ([]byte, error) {
+	b = mystenbcs.AppendULEB128(b, len(v.A))
+	for i1 := range v.A {
+		b = mystenbcs.AppendULEB128(b, len(v.A[i1]))
+		b = append(b, v.A[i1]...)
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.B))
+	for i2 := range v.B {
+		b = mystenbcs.AppendULEB128(b, len(v.B[i2]))
+		for i3 := range v.B[i2] {
+			b = mystenbcs.AppendULEB128(b, len(v.B[i2][i3]))
+			b = append(b, v.B[i2][i3]...)
+		}
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.C))
+	for i4 := range v.C {
+		b = mystenbcs.AppendULEB128(b, len(v.C[i4]))
+		b = append(b, v.C[i4]...)
+	}
+	return b, nil
+}
+
+func (v *ProofPoints) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ProofPoints) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	size1 := r.ReadLen()
+	r.Enter()
+	slice2 := make([]string, 0, min(size1, mystenbcs.MaxPrealloc))
+	for i3 := 0; i3 < size1 && r.Err() == nil; i3++ {
+		var elem4 string
+		elem4 = r.ReadString()
+		slice2 = append(slice2, elem4)
+	}
+	r.Leave()
+	v.A = slice2
+	size5 := r.ReadLen()
+	r.Enter()
+	slice6 := make([][]string, 0, min(size5, mystenbcs.MaxPrealloc))
+	for i7 := 0; i7 < size5 && r.Err() == nil; i7++ {
+		var elem8 []string
+		size9 := r.ReadLen()
+		r.Enter()
+		slice10 := make([]string, 0, min(size9, mystenbcs.MaxPrealloc))
+		for i11 := 0; i11 < size9 && r.Err() == nil; i11++ {
+			var elem12 string
+			elem12 = r.ReadString()
+			slice10 = append(slice10, elem12)
+		}
+		r.Leave()
+		elem8 = slice10
+		slice6 = append(slice6, elem8)
+	}
+	r.Leave()
+	v.B = slice6
+	size13 := r.ReadLen()
+	r.Enter()
+	slice14 := make([]string, 0, min(size13, mystenbcs.MaxPrealloc))
+	for i15 := 0; i15 < size13 && r.Err() == nil; i15++ {
+		var elem16 string
+		elem16 = r.ReadString()
+		slice14 = append(slice14, elem16)
+	}
+	r.Leave()
+	v.C = slice14
+	r.Leave()
+}
+
+func (v IssBase64Details) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *IssBase64Details) AppendBCS(b []byte) ([]byte, error) {
+	b = mystenbcs.AppendULEB128(b, len(v.Value))
+	b = append(b, v.Value...)
+	b = append(b, byte(v.IndexMod4))
+	return b, nil
+}
+
+func (v *IssBase64Details) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *IssBase64Details) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.Value = r.ReadString()
+	v.IndexMod4 = r.ReadUint8()
+	r.Leave()
+}
+
+func (v ZkLoginSignatureInputs) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ZkLoginSignatureInputs) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.ProofPoints.AppendBCS(b); err != nil {
+		return b, err
+	}
+	if b, err = v.IssBase64Details.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = mystenbcs.AppendULEB128(b, len(v.HeaderBase64))
+	b = append(b, v.HeaderBase64...)
+	b = mystenbcs.AppendULEB128(b, len(v.AddressSeed))
+	b = append(b, v.AddressSeed...)
+	return b, nil
+}
+
+func (v *ZkLoginSignatureInputs) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ZkLoginSignatureInputs) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.ProofPoints.DecodeBCS(r)
+	v.IssBase64Details.DecodeBCS(r)
+	v.HeaderBase64 = r.ReadString()
+	v.AddressSeed = r.ReadString()
+	r.Leave()
+}
+
+func (v ZkLoginSignature) MarshalBCS() ([]byte, error) {
+	return v.AppendBCS(nil)
+}
+
+func (v *ZkLoginSignature) AppendBCS(b []byte) ([]byte, error) {
+	var err error
+	if b, err = v.Inputs.AppendBCS(b); err != nil {
+		return b, err
+	}
+	b = binary.LittleEndian.AppendUint64(b, uint64(v.MaxEpoch))
+	b = mystenbcs.AppendULEB128(b, len(v.UserSignature))
+	b = append(b, v.UserSignature...)
+	return b, nil
+}
+
+func (v *ZkLoginSignature) UnmarshalBCS(r io.Reader) (int, error) {
+	br := mystenbcs.NewReader(r)
+	v.DecodeBCS(br)
+	return br.N(), br.Err()
+}
+
+func (v *ZkLoginSignature) DecodeBCS(r *mystenbcs.Reader) {
+	if r.Err() != nil {
+		return
+	}
+	r.Enter()
+	v.Inputs.DecodeBCS(r)
+	v.MaxEpoch = r.ReadUint64()
+	if data1 := r.ReadBytes(); data1 != nil {
+		v.UserSignature = data1
+	}
+	r.Leave()
+}
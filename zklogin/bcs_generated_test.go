@@ -0,0 +1,25 @@
+package zklogin
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs/bcstest"
+)
+
+func TestGeneratedBcs(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(testing.TB, *rand.Rand, int)
+	}{
+		{name: "ProofPoints", check: bcstest.Check[ProofPoints]},
+		{name: "IssBase64Details", check: bcstest.Check[IssBase64Details]},
+		{name: "ZkLoginSignatureInputs", check: bcstest.Check[ZkLoginSignatureInputs]},
+		{name: "ZkLoginSignature", check: bcstest.Check[ZkLoginSignature]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, rand.New(rand.NewSource(1)), 500)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package zklogin
+
+import "errors"
+
+var (
+	ErrMaxEpochExpired       = errors.New("zklogin max epoch expired")
+	ErrMaxEpochTooLarge      = errors.New("zklogin max epoch too large")
+	ErrJwkNotFound           = errors.New("zklogin jwk not found")
+	ErrInvalidProof          = errors.New("zklogin proof verification failed")
+	ErrInvalidUserSignature  = errors.New("zklogin ephemeral signature verification failed")
+	ErrUnsupportedUserScheme = errors.New("zklogin ephemeral signature scheme is not supported")
+	ErrAddressMismatch       = errors.New("zklogin address does not match the signer")
+	ErrMissingVerifyParams   = errors.New("zklogin verify params not set")
+
+	ErrInvalidJwt          = errors.New("invalid jwt")
+	ErrInvalidJwtSignature = errors.New("jwt signature verification failed")
+	ErrJwtExpired          = errors.New("jwt expired")
+	ErrJwtNotYetValid      = errors.New("jwt not yet valid")
+	ErrJwtIssuerMismatch   = errors.New("jwt issuer not accepted")
+	ErrJwtAudienceMismatch = errors.New("jwt audience not accepted")
+)
@@ -0,0 +1,190 @@
+package zklogin
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// VerifyingKey is a Groth16 verifying key on BN254.
+type VerifyingKey struct {
+	Alpha bn254.G1Affine
+	Beta  bn254.G2Affine
+	Gamma bn254.G2Affine
+	Delta bn254.G2Affine
+	// IC holds one point per public input, plus the constant term at index 0.
+	IC []bn254.G1Affine
+}
+
+// snarkjsVerifyingKey is the verification_key.json layout exported by snarkjs.
+type snarkjsVerifyingKey struct {
+	Protocol string     `json:"protocol"`
+	Curve    string     `json:"curve"`
+	NPublic  int        `json:"nPublic"`
+	Alpha    []string   `json:"vk_alpha_1"`
+	Beta     [][]string `json:"vk_beta_2"`
+	Gamma    [][]string `json:"vk_gamma_2"`
+	Delta    [][]string `json:"vk_delta_2"`
+	IC       [][]string `json:"IC"`
+}
+
+// ParseVerifyingKey parses a snarkjs verification_key.json, e.g. for devnet or localnet circuits.
+func ParseVerifyingKey(data []byte) (*VerifyingKey, error) {
+	var raw snarkjsVerifyingKey
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Protocol != "" && raw.Protocol != "groth16" {
+		return nil, fmt.Errorf("unsupported protocol: %s", raw.Protocol)
+	}
+	if raw.Curve != "" && raw.Curve != "bn128" && raw.Curve != "bn254" {
+		return nil, fmt.Errorf("unsupported curve: %s", raw.Curve)
+	}
+
+	return newVerifyingKey(raw.Alpha, raw.Beta, raw.Gamma, raw.Delta, raw.IC)
+}
+
+func newVerifyingKey(alpha []string, beta, gamma, delta [][]string, ic [][]string) (*VerifyingKey, error) {
+	var vk VerifyingKey
+	var err error
+
+	if vk.Alpha, err = parseG1(alpha); err != nil {
+		return nil, fmt.Errorf("invalid vk alpha: %w", err)
+	}
+	if vk.Beta, err = parseG2(beta); err != nil {
+		return nil, fmt.Errorf("invalid vk beta: %w", err)
+	}
+	if vk.Gamma, err = parseG2(gamma); err != nil {
+		return nil, fmt.Errorf("invalid vk gamma: %w", err)
+	}
+	if vk.Delta, err = parseG2(delta); err != nil {
+		return nil, fmt.Errorf("invalid vk delta: %w", err)
+	}
+	if len(ic) < 2 {
+		return nil, fmt.Errorf("invalid vk: expected at least 2 IC points, got %d", len(ic))
+	}
+	vk.IC = make([]bn254.G1Affine, len(ic))
+	for i, point := range ic {
+		if vk.IC[i], err = parseG1(point); err != nil {
+			return nil, fmt.Errorf("invalid vk IC[%d]: %w", i, err)
+		}
+	}
+
+	return &vk, nil
+}
+
+// Groth16Proof is a parsed Groth16 proof.
+type Groth16Proof struct {
+	A bn254.G1Affine
+	B bn254.G2Affine
+	C bn254.G1Affine
+}
+
+// ParseProofPoints converts the proof points carried by a zkLogin signature into curve points.
+func ParseProofPoints(points ProofPoints) (*Groth16Proof, error) {
+	var proof Groth16Proof
+	var err error
+
+	if proof.A, err = parseG1(points.A); err != nil {
+		return nil, fmt.Errorf("invalid proof point a: %w", err)
+	}
+	if proof.B, err = parseG2(points.B); err != nil {
+		return nil, fmt.Errorf("invalid proof point b: %w", err)
+	}
+	if proof.C, err = parseG1(points.C); err != nil {
+		return nil, fmt.Errorf("invalid proof point c: %w", err)
+	}
+
+	return &proof, nil
+}
+
+// VerifyGroth16 checks e(A, B) == e(alpha, beta) * e(sum(IC[i] * input[i]), gamma) * e(C, delta).
+func VerifyGroth16(vk *VerifyingKey, proof *Groth16Proof, publicInputs []*big.Int) (bool, error) {
+	if len(publicInputs)+1 != len(vk.IC) {
+		return false, fmt.Errorf("expected %d public inputs, got %d", len(vk.IC)-1, len(publicInputs))
+	}
+
+	vkX := vk.IC[0]
+	for i, input := range publicInputs {
+		if input.Sign() < 0 || input.Cmp(fr.Modulus()) >= 0 {
+			return false, fmt.Errorf("public input %d is not a field element", i)
+		}
+		var term bn254.G1Affine
+		term.ScalarMultiplication(&vk.IC[i+1], input)
+		vkX.Add(&vkX, &term)
+	}
+
+	var negA bn254.G1Affine
+	negA.Neg(&proof.A)
+
+	return bn254.PairingCheck(
+		[]bn254.G1Affine{negA, vk.Alpha, vkX, proof.C},
+		[]bn254.G2Affine{proof.B, vk.Beta, vk.Gamma, vk.Delta},
+	)
+}
+
+// parseG1 parses a projective point [x, y, z] where z must be 1.
+func parseG1(coords []string) (bn254.G1Affine, error) {
+	var p bn254.G1Affine
+	if len(coords) != 3 {
+		return p, fmt.Errorf("expected 3 coordinates, got %d", len(coords))
+	}
+	if coords[2] != "1" {
+		return p, fmt.Errorf("expected affine point with z = 1")
+	}
+	if err := setFp(&p.X, coords[0]); err != nil {
+		return p, err
+	}
+	if err := setFp(&p.Y, coords[1]); err != nil {
+		return p, err
+	}
+	if !p.IsOnCurve() || !p.IsInSubGroup() {
+		return p, fmt.Errorf("point is not on the curve")
+	}
+
+	return p, nil
+}
+
+// parseG2 parses a projective point [[x0, x1], [y0, y1], [z0, z1]] where z must be 1.
+func parseG2(coords [][]string) (bn254.G2Affine, error) {
+	var p bn254.G2Affine
+	if len(coords) != 3 || len(coords[0]) != 2 || len(coords[1]) != 2 || len(coords[2]) != 2 {
+		return p, fmt.Errorf("expected 3x2 coordinates")
+	}
+	if coords[2][0] != "1" || coords[2][1] != "0" {
+		return p, fmt.Errorf("expected affine point with z = 1")
+	}
+	for _, c := range []struct {
+		e *fp.Element
+		s string
+	}{
+		{&p.X.A0, coords[0][0]},
+		{&p.X.A1, coords[0][1]},
+		{&p.Y.A0, coords[1][0]},
+		{&p.Y.A1, coords[1][1]},
+	} {
+		if err := setFp(c.e, c.s); err != nil {
+			return p, err
+		}
+	}
+	if !p.IsOnCurve() || !p.IsInSubGroup() {
+		return p, fmt.Errorf("point is not on the curve")
+	}
+
+	return p, nil
+}
+
+// setFp sets a base field element from a canonical decimal string.
+func setFp(e *fp.Element, s string) error {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 || v.Cmp(fp.Modulus()) >= 0 {
+		return fmt.Errorf("invalid field element: %s", s)
+	}
+	e.SetBigInt(v)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package zklogin
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// JwkId identifies a JWK by the OIDC issuer and the key id from the JWT header.
+type JwkId struct {
+	Iss string `json:"iss"`
+	Kid string `json:"kid"`
+}
+
+// JWK is an RSA JSON web key published by an OIDC provider.
+type JWK struct {
+	Kty string `json:"kty"`
+	E   string `json:"e"`
+	N   string `json:"n"`
+	Alg string `json:"alg"`
+}
+
+// JwkSet holds the JWKs trusted by the verifier, keyed by issuer and key id.
+type JwkSet map[JwkId]JWK
+
+// Modulus returns the big endian RSA modulus of the key.
+func (jwk JWK) Modulus() ([]byte, error) {
+	if jwk.Kty != "RSA" {
+		return nil, fmt.Errorf("unsupported jwk kty: %s", jwk.Kty)
+	}
+	if jwk.E != "AQAB" {
+		return nil, fmt.Errorf("unsupported jwk exponent: %s", jwk.E)
+	}
+
+	modulus, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.N, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwk modulus: %v", err)
+	}
+
+	return modulus, nil
+}
+
+// RSAPublicKey returns the RSA public key used to verify RS256 JWTs.
+func (jwk JWK) RSAPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := jwk.Modulus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: 65537,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package zklogin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+// jwksDocument is the JSON document published at the JWKS endpoint of a provider.
+type jwksDocument struct {
+	Keys []struct {
+		JWK
+		Kid string `json:"kid"`
+	} `json:"keys"`
+}
+
+// JwkStore is a concurrency safe set of trusted JWKs.
+type JwkStore struct {
+	mu   sync.RWMutex
+	jwks JwkSet
+}
+
+func NewJwkStore() *JwkStore {
+	return &JwkStore{
+		jwks: make(JwkSet),
+	}
+}
+
+// Add trusts a single JWK, the legacy Google issuer is stored as the canonical one.
+func (s *JwkStore) Add(id JwkId, jwk JWK) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id.Iss = normalizeIssuer(id.Iss)
+	s.jwks[id] = jwk
+}
+
+// AddJwks trusts the RSA keys of a JWKS document published by the given issuer.
+func (s *JwkStore) AddJwks(iss string, data []byte) error {
+	var doc jwksDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse jwks: %v", err)
+	}
+
+	for _, key := range doc.Keys {
+		if key.Kty != "RSA" || key.Kid == "" {
+			continue
+		}
+		s.Add(JwkId{Iss: iss, Kid: key.Kid}, key.JWK)
+	}
+
+	return nil
+}
+
+// AddJwksFile trusts the keys of a JWKS document stored on disk.
+func (s *JwkStore) AddJwksFile(iss string, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return s.AddJwks(iss, data)
+}
+
+// AddActiveJwks trusts the JWKs active on chain, e.g. the new_active_jwks of the epoch data or the
+// jwks of a signature verification request.
+func (s *JwkStore) AddActiveJwks(activeJwks []*v2.ActiveJwk) {
+	for _, active := range activeJwks {
+		if active.GetId() == nil || active.GetJwk() == nil {
+			continue
+		}
+		s.Add(JwkId{
+			Iss: active.GetId().GetIss(),
+			Kid: active.GetId().GetKid(),
+		}, JWK{
+			Kty: active.GetJwk().GetKty(),
+			E:   active.GetJwk().GetE(),
+			N:   active.GetJwk().GetN(),
+			Alg: active.GetJwk().GetAlg(),
+		})
+	}
+}
+
+// Get returns the JWK trusted for the issuer and key id.
+func (s *JwkStore) Get(iss, kid string) (JWK, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	jwk, ok := s.jwks[JwkId{Iss: normalizeIssuer(iss), Kid: kid}]
+	return jwk, ok
+}
+
+// JwkSet returns a copy of the trusted keys, to be used as VerifyParams.Jwks.
+func (s *JwkStore) JwkSet() JwkSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	jwks := make(JwkSet, len(s.jwks))
+	for id, jwk := range s.jwks {
+		jwks[id] = jwk
+	}
+
+	return jwks
+}
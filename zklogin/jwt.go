@@ -0,0 +1,146 @@
+package zklogin
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Audience is the `aud` claim, which may be either a string or an array of strings.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("invalid aud claim: %v", err)
+	}
+	*a = multiple
+
+	return nil
+}
+
+// JwtClaims holds the registered claims used by zkLogin.
+type JwtClaims struct {
+	Iss   string   `json:"iss"`
+	Sub   string   `json:"sub"`
+	Aud   Audience `json:"aud"`
+	Exp   int64    `json:"exp"`
+	Nbf   int64    `json:"nbf,omitempty"`
+	Iat   int64    `json:"iat,omitempty"`
+	Nonce string   `json:"nonce,omitempty"`
+}
+
+// JwtVerifyOptions controls the claim checks of VerifyJwt.
+type JwtVerifyOptions struct {
+	// Providers limits the accepted issuers, any issuer with a trusted JWK is accepted when empty.
+	Providers []OIDCProvider
+	// Audiences are the accepted client ids, the audience is not checked when empty.
+	Audiences []string
+	// Now defaults to time.Now.
+	Now func() time.Time
+	// Leeway tolerates clock skew when checking exp and nbf.
+	Leeway time.Duration
+}
+
+// VerifyJwt verifies the RS256 signature of the JWT against the trusted JWKs, checks
+// the iss, aud, exp and nbf claims, and returns the decoded claims.
+func VerifyJwt(token string, store *JwkStore, options *JwtVerifyOptions) (*JwtClaims, error) {
+	if options == nil {
+		options = &JwtVerifyOptions{}
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidJwt
+	}
+
+	header, err := decodeJwtHeader(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwt payload: %v", err)
+	}
+	var claims JwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse jwt payload: %v", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwt signature: %v", err)
+	}
+
+	if len(options.Providers) > 0 {
+		provider, ok := ProviderFromIssuer(claims.Iss)
+		if !ok || !containsProvider(options.Providers, provider) {
+			return nil, ErrJwtIssuerMismatch
+		}
+	}
+
+	jwk, ok := store.Get(claims.Iss, header.Kid)
+	if !ok {
+		return nil, ErrJwkNotFound
+	}
+	if jwk.Alg != "" && jwk.Alg != header.Alg {
+		return nil, fmt.Errorf("jwk alg %s does not match jwt alg %s", jwk.Alg, header.Alg)
+	}
+	publicKey, err := jwk.RSAPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, ErrInvalidJwtSignature
+	}
+
+	now := time.Now()
+	if options.Now != nil {
+		now = options.Now()
+	}
+	if claims.Exp == 0 || now.Add(-options.Leeway).After(time.Unix(claims.Exp, 0)) {
+		return nil, ErrJwtExpired
+	}
+	if claims.Nbf != 0 && now.Add(options.Leeway).Before(time.Unix(claims.Nbf, 0)) {
+		return nil, ErrJwtNotYetValid
+	}
+
+	if len(options.Audiences) > 0 && !claims.Aud.containsAny(options.Audiences) {
+		return nil, ErrJwtAudienceMismatch
+	}
+
+	return &claims, nil
+}
+
+func (a Audience) containsAny(audiences []string) bool {
+	for _, aud := range a {
+		for _, accepted := range audiences {
+			if aud == accepted {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsProvider(providers []OIDCProvider, provider OIDCProvider) bool {
+	for _, p := range providers {
+		if p == provider {
+			return true
+		}
+	}
+
+	return false
+}
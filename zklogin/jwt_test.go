@@ -0,0 +1,123 @@
+package zklogin
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+)
+
+func signTestJwt(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerifyJwt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+	store := NewJwkStore()
+	require.NoError(t, store.AddJwks(ProviderIssuers[ProviderGoogle], []byte(fmt.Sprintf(
+		`{"keys":[{"kty":"RSA","alg":"RS256","use":"sig","kid":"google-kid","e":"AQAB","n":"%s"}]}`, n))))
+	twitch := ProviderIssuers[ProviderTwitch]
+	store.AddActiveJwks([]*v2.ActiveJwk{{
+		Id:  &v2.JwkId{Iss: &twitch, Kid: strPtr("twitch-kid")},
+		Jwk: &v2.Jwk{Kty: strPtr("RSA"), E: strPtr("AQAB"), N: &n, Alg: strPtr("RS256")},
+	}})
+
+	now := time.Unix(1700000000, 0)
+	claims := func(iss string, aud interface{}, exp int64) map[string]interface{} {
+		return map[string]interface{}{"iss": iss, "sub": "1234", "aud": aud, "exp": exp, "nonce": "abc"}
+	}
+	options := &JwtVerifyOptions{
+		Providers: []OIDCProvider{ProviderGoogle, ProviderTwitch},
+		Audiences: []string{"client-id"},
+		Now:       func() time.Time { return now },
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "google token",
+			token: signTestJwt(t, key, "google-kid", claims("https://accounts.google.com", "client-id", now.Unix()+60)),
+		},
+		{
+			name:  "legacy google issuer",
+			token: signTestJwt(t, key, "google-kid", claims("accounts.google.com", "client-id", now.Unix()+60)),
+		},
+		{
+			name:  "on-chain twitch jwk with audience array",
+			token: signTestJwt(t, key, "twitch-kid", claims(twitch, []string{"other", "client-id"}, now.Unix()+60)),
+		},
+		{
+			name:    "forged signature",
+			token:   signTestJwt(t, otherKey, "google-kid", claims("https://accounts.google.com", "client-id", now.Unix()+60)),
+			wantErr: ErrInvalidJwtSignature,
+		},
+		{
+			name:    "unknown kid",
+			token:   signTestJwt(t, key, "unknown", claims("https://accounts.google.com", "client-id", now.Unix()+60)),
+			wantErr: ErrJwkNotFound,
+		},
+		{
+			name:    "expired",
+			token:   signTestJwt(t, key, "google-kid", claims("https://accounts.google.com", "client-id", now.Unix()-1)),
+			wantErr: ErrJwtExpired,
+		},
+		{
+			name:    "wrong audience",
+			token:   signTestJwt(t, key, "google-kid", claims("https://accounts.google.com", "other", now.Unix()+60)),
+			wantErr: ErrJwtAudienceMismatch,
+		},
+		{
+			name:    "provider not accepted",
+			token:   signTestJwt(t, key, "google-kid", claims(ProviderIssuers[ProviderApple], "client-id", now.Unix()+60)),
+			wantErr: ErrJwtIssuerMismatch,
+		},
+		{
+			name:    "malformed",
+			token:   "abc.def",
+			wantErr: ErrInvalidJwt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyJwt(tt.token, store, options)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "1234", got.Sub)
+			require.Equal(t, "abc", got.Nonce)
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
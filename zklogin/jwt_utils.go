@@ -1,6 +1,7 @@
 package zklogin
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -94,7 +95,7 @@ func decodeBase64URL(s string, i int) (string, error) {
 		// Convert bitChunk to a byte
 		var byteValue byte
 		for j, bit := range bitChunk {
-			if bit == '1' {
+			if bit == 1 {
 				byteValue |= 1 << (7 - j)
 			}
 		}
@@ -155,3 +156,28 @@ func extractClaimValue(claim Claim, claimName string) (string, error) {
 		return "", errors.New("value is not a string")
 	}
 }
+
+// JwtHeader is the subset of the JWT header used by zkLogin.
+type JwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// decodeJwtHeader decodes the base64url JWT header committed to in the zkLogin inputs.
+func decodeJwtHeader(headerBase64 string) (*JwtHeader, error) {
+	headerBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(headerBase64, "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwt header: %v", err)
+	}
+
+	var header JwtHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse jwt header: %v", err)
+	}
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("unsupported jwt alg: %s", header.Alg)
+	}
+
+	return &header, nil
+}
@@ -0,0 +1,159 @@
+package zklogin
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+)
+
+const (
+	// packWidth is the number of bits packed into one field element.
+	packWidth = 248
+	// maxHeaderLen is the max length of the base64 JWT header accepted by the circuit.
+	maxHeaderLen = 248
+	// maxIssLenB64 is the max length of the base64 iss claim accepted by the circuit.
+	maxIssLenB64 = 4 * (1 + maxExtIssLen/3)
+	maxExtIssLen = 165
+	// modulusBits is the width of the RSA modulus of the JWK.
+	modulusBits = 2048
+)
+
+// poseidonZkLogin hashes up to 32 field elements the same way as the zkLogin circuit:
+// inputs above 16 are split in two halves and the two digests hashed again.
+func poseidonZkLogin(inputs []*big.Int) (*big.Int, error) {
+	switch {
+	case len(inputs) == 0 || len(inputs) > 32:
+		return nil, fmt.Errorf("invalid poseidon input length: %d", len(inputs))
+	case len(inputs) <= 16:
+		return poseidon.Hash(inputs)
+	default:
+		first, err := poseidon.Hash(inputs[:16])
+		if err != nil {
+			return nil, err
+		}
+		second, err := poseidon.Hash(inputs[16:])
+		if err != nil {
+			return nil, err
+		}
+		return poseidon.Hash([]*big.Int{first, second})
+	}
+}
+
+// hashASCIIStrToField pads the ascii string with zeros to maxLen and hashes it to a field element.
+func hashASCIIStrToField(str string, maxLen int) (*big.Int, error) {
+	if len(str) > maxLen {
+		return nil, fmt.Errorf("string %q is longer than %d", str, maxLen)
+	}
+
+	charCodes := make([]*big.Int, maxLen)
+	for i := range charCodes {
+		charCodes[i] = new(big.Int)
+	}
+	for i, c := range []byte(str) {
+		if c > 127 {
+			return nil, errors.New("string is not ascii")
+		}
+		charCodes[i].SetUint64(uint64(c))
+	}
+
+	return hashToField(charCodes, 8)
+}
+
+// hashToField packs the inWidth-bit inputs into 248-bit chunks and hashes them.
+func hashToField(inputs []*big.Int, inWidth int) (*big.Int, error) {
+	packed, err := convertBase(inputs, inWidth, packWidth)
+	if err != nil {
+		return nil, err
+	}
+
+	return poseidonZkLogin(packed)
+}
+
+// convertBase concatenates the big endian bits of all inputs and splits them into outWidth-bit
+// chunks, starting from the least significant end.
+func convertBase(inputs []*big.Int, inWidth, outWidth int) ([]*big.Int, error) {
+	bits := make([]uint, 0, len(inputs)*inWidth)
+	for _, input := range inputs {
+		if input.Sign() < 0 || input.BitLen() > inWidth {
+			return nil, fmt.Errorf("input does not fit in %d bits", inWidth)
+		}
+		for i := inWidth - 1; i >= 0; i-- {
+			bits = append(bits, input.Bit(i))
+		}
+	}
+
+	var packed []*big.Int
+	for end := len(bits); end > 0; end -= outWidth {
+		start := end - outWidth
+		if start < 0 {
+			start = 0
+		}
+		chunk := new(big.Int)
+		for _, bit := range bits[start:end] {
+			chunk.Lsh(chunk, 1)
+			chunk.SetBit(chunk, 0, bit)
+		}
+		packed = append([]*big.Int{chunk}, packed...)
+	}
+
+	return packed, nil
+}
+
+// splitToTwoFrs splits the extended ephemeral public key into its leading bytes and its last 16 bytes.
+func splitToTwoFrs(ephPkBytes []byte) (*big.Int, *big.Int, error) {
+	if len(ephPkBytes) <= 16 {
+		return nil, nil, errors.New("invalid ephemeral public key length")
+	}
+	first := new(big.Int).SetBytes(ephPkBytes[:len(ephPkBytes)-16])
+	second := new(big.Int).SetBytes(ephPkBytes[len(ephPkBytes)-16:])
+	if first.Cmp(fr.Modulus()) >= 0 {
+		return nil, nil, errors.New("ephemeral public key does not fit in a field element")
+	}
+
+	return first, second, nil
+}
+
+// CalculateAllInputsHash computes the single public input of the zkLogin circuit.
+//   - ephPkBytes is the ephemeral public key prefixed with its scheme flag.
+//   - modulus is the big endian RSA modulus of the JWK that signed the JWT.
+func (inputs *ZkLoginSignatureInputs) CalculateAllInputsHash(ephPkBytes []byte, modulus []byte, maxEpoch uint64) (*big.Int, error) {
+	if len(inputs.HeaderBase64) > maxHeaderLen {
+		return nil, errors.New("header is too long")
+	}
+
+	addressSeed, ok := new(big.Int).SetString(inputs.AddressSeed, 10)
+	if !ok || addressSeed.Sign() < 0 || addressSeed.Cmp(fr.Modulus()) >= 0 {
+		return nil, fmt.Errorf("invalid address seed: %s", inputs.AddressSeed)
+	}
+
+	first, second, err := splitToTwoFrs(ephPkBytes)
+	if err != nil {
+		return nil, err
+	}
+	issBase64F, err := hashASCIIStrToField(inputs.IssBase64Details.Value, maxIssLenB64)
+	if err != nil {
+		return nil, err
+	}
+	headerF, err := hashASCIIStrToField(inputs.HeaderBase64, maxHeaderLen)
+	if err != nil {
+		return nil, err
+	}
+	modulusF, err := hashToField([]*big.Int{new(big.Int).SetBytes(modulus)}, modulusBits)
+	if err != nil {
+		return nil, err
+	}
+
+	return poseidonZkLogin([]*big.Int{
+		first,
+		second,
+		addressSeed,
+		new(big.Int).SetUint64(maxEpoch),
+		issBase64F,
+		new(big.Int).SetUint64(uint64(inputs.IssBase64Details.IndexMod4)),
+		headerF,
+		modulusF,
+	})
+}
@@ -0,0 +1,63 @@
+package zklogin
+
+// OIDCProvider is an OpenID provider supported by zkLogin.
+type OIDCProvider string
+
+const (
+	ProviderGoogle     OIDCProvider = "Google"
+	ProviderFacebook   OIDCProvider = "Facebook"
+	ProviderTwitch     OIDCProvider = "Twitch"
+	ProviderApple      OIDCProvider = "Apple"
+	ProviderSlack      OIDCProvider = "Slack"
+	ProviderKakao      OIDCProvider = "Kakao"
+	ProviderMicrosoft  OIDCProvider = "Microsoft"
+	ProviderKarrierOne OIDCProvider = "KarrierOne"
+	ProviderCredenza3  OIDCProvider = "Credenza3"
+)
+
+// ProviderIssuers maps each provider to the `iss` claim of the JWTs it issues.
+var ProviderIssuers = map[OIDCProvider]string{
+	ProviderGoogle:     "https://accounts.google.com",
+	ProviderFacebook:   "https://www.facebook.com",
+	ProviderTwitch:     "https://id.twitch.tv/oauth2",
+	ProviderApple:      "https://appleid.apple.com",
+	ProviderSlack:      "https://slack.com",
+	ProviderKakao:      "https://kauth.kakao.com",
+	ProviderMicrosoft:  "https://login.microsoftonline.com/9188040d-6c67-4c5b-b112-36a304b66dad/v2.0",
+	ProviderKarrierOne: "https://accounts.karrier.one/",
+	ProviderCredenza3:  "https://accounts.credenza3.com",
+}
+
+// ProviderJwksURLs maps each provider to the URL publishing its JWK set.
+var ProviderJwksURLs = map[OIDCProvider]string{
+	ProviderGoogle:     "https://www.googleapis.com/oauth2/v3/certs",
+	ProviderFacebook:   "https://www.facebook.com/.well-known/oauth/openid/jwks/",
+	ProviderTwitch:     "https://id.twitch.tv/oauth2/keys",
+	ProviderApple:      "https://appleid.apple.com/auth/keys",
+	ProviderSlack:      "https://slack.com/openid/connect/keys",
+	ProviderKakao:      "https://kauth.kakao.com/.well-known/jwks.json",
+	ProviderMicrosoft:  "https://login.microsoftonline.com/common/discovery/v2.0/keys",
+	ProviderKarrierOne: "https://accounts.karrier.one/.well-known/jwks.json",
+	ProviderCredenza3:  "https://accounts.credenza3.com/jwks",
+}
+
+// ProviderFromIssuer returns the provider issuing JWTs with the given `iss` claim.
+func ProviderFromIssuer(iss string) (OIDCProvider, bool) {
+	iss = normalizeIssuer(iss)
+	for provider, issuer := range ProviderIssuers {
+		if issuer == iss {
+			return provider, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeIssuer maps the legacy Google issuer without scheme to the canonical one.
+func normalizeIssuer(iss string) string {
+	if iss == "accounts.google.com" {
+		return ProviderIssuers[ProviderGoogle]
+	}
+
+	return iss
+}
@@ -2,13 +2,16 @@ package zklogin
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/machinebox/graphql"
 
+	"github.com/block-vision/sui-go-sdk/constant"
 	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 )
 
@@ -22,6 +25,8 @@ type SignaturePubkeyPair struct {
 
 type ZkLoginPublicIdentifierOptions struct {
 	Client *graphql.Client
+	// Params enables offline verification, the GraphQL client is not used when it is set.
+	Params *VerifyParams
 }
 
 type ZkLoginPublicIdentifier struct {
@@ -30,29 +35,86 @@ type ZkLoginPublicIdentifier struct {
 }
 
 func NewZkLoginPublicIdentifier(data []byte, options *ZkLoginPublicIdentifierOptions) *ZkLoginPublicIdentifier {
-	panic("not implemented")
+	return &ZkLoginPublicIdentifier{
+		data:    data,
+		options: options,
+	}
 }
 
-/**
- * Return the byte array representation of the zkLogin public identifier
- */
-func (p *ZkLoginPublicIdentifier) toRawBytes() []byte {
+func (p *ZkLoginPublicIdentifier) ToSuiAddress() string {
+	return models.PublicKeyToSuiAddress(p.Flag(), p.data)
+}
+
+// ToRawBytes returns the zkLogin public identifier `iss_len || iss || address_seed`.
+func (p *ZkLoginPublicIdentifier) ToRawBytes() []byte {
 	return p.data
 }
 
-func (p *ZkLoginPublicIdentifier) ToSuiAddress() string {
-	panic("not implemented")
+func (p *ZkLoginPublicIdentifier) Scheme() scheme.SignatureScheme {
+	return scheme.ZkLogin
+}
+
+func (p *ZkLoginPublicIdentifier) Flag() byte {
+	return scheme.SignatureSchemeToFlag[scheme.ZkLogin]
+}
 
-	// Each hex char represents half a byte, hence hex address doubles the length
-	// return normalizeSuiAddress(
-	// 	bytesToHex(blake2b(this.toSuiBytes(), { dkLen: 32 })).slice(0, SUI_ADDRESS_LENGTH * 2),
-	// );
+func (p *ZkLoginPublicIdentifier) ToSuiBytes() []byte {
+	return models.ToSuiBytes(p.Flag(), p.data)
+}
 
-	// Convert the public identifier to a Sui address
-	// return "0x" + mystenbcs.ToHex(mystenbcs.Blake2b(p.toSuiBytes(), 32))[:40]
+func (p *ZkLoginPublicIdentifier) ToSuiPublicKey() string {
+	return base64.StdEncoding.EncodeToString(p.ToSuiBytes())
 }
 
 func (pk *ZkLoginPublicIdentifier) VerifyPersonalMessage(message []byte, signature []byte, client *graphql.Client) (bool, error) {
+	if pk.options != nil && pk.options.Params != nil {
+		intentMessage := models.NewPersonalMessageIntentMessage(message)
+		return pk.VerifyWithIntent(intentMessage.Value, signature, intentMessage.Intent.Scope)
+	}
+
+	return pk.graphqlVerify(message, signature, "PERSONAL_MESSAGE", client)
+}
+
+func (pk *ZkLoginPublicIdentifier) VerifyTransaction(txBytes []byte, signature []byte, client *graphql.Client) (bool, error) {
+	if pk.options != nil && pk.options.Params != nil {
+		return pk.VerifyWithIntent(txBytes, signature, constant.TransactionDataIntentScope)
+	}
+
+	return pk.graphqlVerify(txBytes, signature, "TRANSACTION_DATA", client)
+}
+
+// VerifyWithIntent verifies the serialized zkLogin signature over the intent message offline,
+// which requires the verify params to be set in the options.
+func (pk *ZkLoginPublicIdentifier) VerifyWithIntent(bytes []byte, signature []byte, scope constant.IntentScope) (bool, error) {
+	if pk.options == nil || pk.options.Params == nil {
+		return false, ErrMissingVerifyParams
+	}
+
+	parsedSignature, err := ParseSerializedZkLoginSignature(signature)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse serialized zkLogin signature: %w", err)
+	}
+
+	intentMessage := models.NewMessageWithIntent(bytes, scope)
+	signer, err := VerifyZkLoginSignature(intentMessage, parsedSignature.ZkLogin, pk.options.Params)
+	if err != nil {
+		return false, err
+	}
+	if signer != pk.ToSuiAddress() {
+		return false, ErrAddressMismatch
+	}
+
+	return true, nil
+}
+
+func (pk *ZkLoginPublicIdentifier) graphqlVerify(bytes []byte, signature []byte, intentScope string, client *graphql.Client) (bool, error) {
+	if client == nil && pk.options != nil {
+		client = pk.options.Client
+	}
+	if client == nil {
+		return false, ErrMissingVerifyParams
+	}
+
 	// Parse the serialized zkLogin signature
 	parsedSignature, err := ParseSerializedZkLoginSignature(signature)
 	if err != nil {
@@ -63,13 +125,16 @@ func (pk *ZkLoginPublicIdentifier) VerifyPersonalMessage(message []byte, signatu
 	address := pk.ToSuiAddress()
 
 	// Convert the message to Base64
-	bytesEncoded := mystenbcs.ToBase64(message)
+	bytesEncoded := mystenbcs.ToBase64(bytes)
 
 	// Call the GraphQL verification function
-	return GraphqlVerifyZkLoginSignature(address, bytesEncoded, string(parsedSignature.SerializedSignature), "PERSONAL_MESSAGE", client)
+	return GraphqlVerifyZkLoginSignature(address, bytesEncoded, string(parsedSignature.SerializedSignature), intentScope, client)
 }
 
+// toZkLoginPublicIdentifier builds the identifier from the normalized issuer, so a legacy
+// "accounts.google.com" iss maps to the same address as "https://accounts.google.com".
 func toZkLoginPublicIdentifier(addressSeed *big.Int, iss string, options *ZkLoginPublicIdentifierOptions) *ZkLoginPublicIdentifier {
+	iss = normalizeIssuer(iss)
 	addressSeedBytesBigEndian := ToPaddedBigEndianBytes(addressSeed, 32)
 
 	issBytes := []byte(iss)
@@ -143,7 +208,7 @@ func ParseSerializedZkLoginSignature(signature interface{}) (*SignaturePubkeyPai
 	}
 
 	// Check if the signature scheme is correct
-	if bytes[0] != scheme.SignatureSchemeToFlag[scheme.ZkLogin] {
+	if len(bytes) == 0 || bytes[0] != scheme.SignatureSchemeToFlag[scheme.ZkLogin] {
 		return nil, errors.New("invalid signature scheme")
 	}
 
@@ -173,7 +238,10 @@ func ParseSerializedZkLoginSignature(signature interface{}) (*SignaturePubkeyPai
 	zkSig.Iss = iss
 
 	// Calculate the public identifier (you need to implement toZkLoginPublicIdentifier)
-	addressSeedBigInt, _ := new(big.Int).SetString(addressSeed, 10)
+	addressSeedBigInt, ok := new(big.Int).SetString(addressSeed, 10)
+	if !ok || addressSeedBigInt.Sign() < 0 || addressSeedBigInt.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid address seed: %s", addressSeed)
+	}
 	publicIdentifier := toZkLoginPublicIdentifier(addressSeedBigInt, iss, nil)
 
 	// Return the parsed signature data
@@ -181,7 +249,7 @@ func ParseSerializedZkLoginSignature(signature interface{}) (*SignaturePubkeyPai
 		SerializedSignature: mystenbcs.ToBase64(bytes),
 		SignatureScheme:     scheme.ZkLogin,
 		ZkLogin:             zkSig,
-		Signature:           signatureBytes,
-		PubKey:              publicIdentifier.toRawBytes(),
+		Signature:           bytes,
+		PubKey:              publicIdentifier.ToRawBytes(),
 	}, nil
 }
@@ -3,11 +3,13 @@ package zklogin
 import (
 	"fmt"
 
-	"github.com/fardream/go-bcs/bcs"
-
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 )
 
+// maxZkLoginSignatureSize bounds the bcs of a zkLogin signature, the signatures of the network are
+// about 1.5 KiB.
+const maxZkLoginSignatureSize = 8 * 1024
+
 func parseZkLoginSignature(signature interface{}) (*ZkLoginSignature, error) {
 	var bytes []byte
 	var err error
@@ -27,12 +29,13 @@ func parseZkLoginSignature(signature interface{}) (*ZkLoginSignature, error) {
 
 	// Deserialize the bytes into ZkLoginSignature struct using BCS
 	var zkSig ZkLoginSignature
-	numBytes, err := bcs.Unmarshal(bytes, &zkSig)
+	_, err = mystenbcs.UnmarshalWithOptions(bytes, &zkSig, &mystenbcs.DecodeOptions{
+		MaxBytes:            maxZkLoginSignatureSize,
+		RejectTrailingBytes: true,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse BCS data: %v", err)
 	}
 
-	fmt.Println("Number of bytes read:", numBytes)
-
 	return &zkSig, nil
 }
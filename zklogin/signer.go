@@ -0,0 +1,81 @@
+package zklogin
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+// ZkLoginSigner signs for a zkLogin address with an ephemeral key and the zero-knowledge
+// proof fetched from a prover. The ephemeral key may itself be any models.Signer.
+type ZkLoginSigner struct {
+	ephemeral  models.Signer
+	inputs     ZkLoginSignatureInputs
+	maxEpoch   uint64
+	identifier *ZkLoginPublicIdentifier
+}
+
+var _ models.Signer = (*ZkLoginSigner)(nil)
+
+// NewZkLoginSigner creates a signer from the ephemeral key signer, the proof inputs and the
+// max epoch the ephemeral key was registered for.
+func NewZkLoginSigner(ephemeral models.Signer, inputs ZkLoginSignatureInputs, maxEpoch uint64) (*ZkLoginSigner, error) {
+	addressSeed, ok := new(big.Int).SetString(inputs.AddressSeed, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid address seed: %s", inputs.AddressSeed)
+	}
+	iss, err := extractClaimValue(Claim{
+		Value:     inputs.IssBase64Details.Value,
+		IndexMod4: int(inputs.IssBase64Details.IndexMod4),
+	}, "iss")
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract claim value: %v", err)
+	}
+
+	return &ZkLoginSigner{
+		ephemeral:  ephemeral,
+		inputs:     inputs,
+		maxEpoch:   maxEpoch,
+		identifier: toZkLoginPublicIdentifier(addressSeed, iss, nil),
+	}, nil
+}
+
+func (s *ZkLoginSigner) GetKeyScheme() scheme.SignatureScheme {
+	return scheme.ZkLogin
+}
+
+// GetPublicKey returns the zkLogin public identifier `len(iss) || iss || address_seed`.
+func (s *ZkLoginSigner) GetPublicKey() []byte {
+	return s.identifier.ToRawBytes()
+}
+
+func (s *ZkLoginSigner) ToSuiAddress() string {
+	return s.identifier.ToSuiAddress()
+}
+
+// Sign signs the digest with the ephemeral key and returns the serialized zkLogin signature.
+func (s *ZkLoginSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	userSignature, err := models.SignDigest(ctx, s.ephemeral, digest)
+	if err != nil {
+		return nil, err
+	}
+	userSignatureBytes, err := mystenbcs.FromBase64(userSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := mystenbcs.Marshal(&ZkLoginSignature{
+		Inputs:        s.inputs,
+		MaxEpoch:      s.maxEpoch,
+		UserSignature: userSignatureBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{scheme.SignatureSchemeToFlag[scheme.ZkLogin]}, signature...), nil
+}
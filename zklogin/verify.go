@@ -0,0 +1,150 @@
+package zklogin
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256k1"
+	"github.com/block-vision/sui-go-sdk/keypairs/secp256r1"
+)
+
+// VerifyParams holds everything needed to verify a zkLogin signature without a fullnode.
+type VerifyParams struct {
+	// Jwks are the provider keys trusted by the verifier.
+	Jwks JwkSet
+	// CurrentEpoch is the epoch the signature is checked against, it must not be after the max epoch.
+	CurrentEpoch uint64
+	// MaxEpochUpperBoundDelta optionally limits how far in the future the max epoch may be.
+	MaxEpochUpperBoundDelta *uint64
+	// VerifyingKey defaults to the embedded production key when nil.
+	VerifyingKey *VerifyingKey
+}
+
+// VerifyZkLoginSignature verifies a zkLogin signature over an intent message offline and
+// returns the zkLogin address of the signer.
+//
+// The following checks are performed:
+//   - the max epoch of the ephemeral key has not passed
+//   - the address seed is a decimal integer
+//   - the ephemeral signature over the intent message digest
+//   - the JWK referenced by the JWT header is trusted
+//   - the Groth16 proof against the public inputs hash
+func VerifyZkLoginSignature(intentMessage []byte, signature *ZkLoginSignature, params *VerifyParams) (string, error) {
+	if params == nil {
+		return "", ErrMissingVerifyParams
+	}
+
+	if params.CurrentEpoch > signature.MaxEpoch {
+		return "", ErrMaxEpochExpired
+	}
+	if params.MaxEpochUpperBoundDelta != nil && signature.MaxEpoch-params.CurrentEpoch > *params.MaxEpochUpperBoundDelta {
+		return "", ErrMaxEpochTooLarge
+	}
+
+	inputs := signature.Inputs
+	addressSeed, ok := new(big.Int).SetString(inputs.AddressSeed, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid address seed: %s", inputs.AddressSeed)
+	}
+
+	digest := blake2b.Sum256(intentMessage)
+	ephPkBytes, err := verifyUserSignature(digest[:], signature.UserSignature)
+	if err != nil {
+		return "", err
+	}
+
+	iss := signature.Iss
+	if iss == "" {
+		iss, err = extractClaimValue(Claim{
+			Value:     inputs.IssBase64Details.Value,
+			IndexMod4: int(inputs.IssBase64Details.IndexMod4),
+		}, "iss")
+		if err != nil {
+			return "", fmt.Errorf("failed to extract claim value: %v", err)
+		}
+	}
+
+	iss = normalizeIssuer(iss)
+
+	header, err := decodeJwtHeader(inputs.HeaderBase64)
+	if err != nil {
+		return "", err
+	}
+	jwk, ok := params.Jwks[JwkId{Iss: iss, Kid: header.Kid}]
+	if !ok {
+		return "", ErrJwkNotFound
+	}
+	modulus, err := jwk.Modulus()
+	if err != nil {
+		return "", err
+	}
+
+	allInputsHash, err := inputs.CalculateAllInputsHash(ephPkBytes, modulus, signature.MaxEpoch)
+	if err != nil {
+		return "", err
+	}
+
+	vk := params.VerifyingKey
+	if vk == nil {
+		if vk, err = ProdVerifyingKey(); err != nil {
+			return "", err
+		}
+	}
+	proof, err := ParseProofPoints(inputs.ProofPoints)
+	if err != nil {
+		return "", err
+	}
+	pass, err := VerifyGroth16(vk, proof, []*big.Int{allInputsHash})
+	if err != nil {
+		return "", err
+	}
+	if !pass {
+		return "", ErrInvalidProof
+	}
+
+	return toZkLoginPublicIdentifier(addressSeed, iss, nil).ToSuiAddress(), nil
+}
+
+// verifyUserSignature verifies the serialized ephemeral signature `flag || signature || pubkey`
+// over the digest, and returns the ephemeral public key prefixed with its flag.
+func verifyUserSignature(digest []byte, userSignature []byte) ([]byte, error) {
+	if len(userSignature) == 0 {
+		return nil, ErrInvalidUserSignature
+	}
+
+	switch scheme.SignatureFlagToScheme[userSignature[0]] {
+	case scheme.ED25519:
+		if len(userSignature) != 1+ed25519.SignatureSize+ed25519.PublicKeySize {
+			return nil, ErrInvalidUserSignature
+		}
+		sig := userSignature[1 : 1+ed25519.SignatureSize]
+		pubKey := userSignature[1+ed25519.SignatureSize:]
+		if !ed25519.Verify(pubKey, digest, sig) {
+			return nil, ErrInvalidUserSignature
+		}
+		return append([]byte{userSignature[0]}, pubKey...), nil
+	case scheme.Secp256k1, scheme.Secp256r1:
+		if len(userSignature) != 1+secp256k1.SignatureSize+secp256k1.PublicKeySize {
+			return nil, ErrInvalidUserSignature
+		}
+		sig := userSignature[1 : 1+secp256k1.SignatureSize]
+		pubKey := userSignature[1+secp256k1.SignatureSize:]
+		var pass bool
+		var err error
+		if userSignature[0] == scheme.SignatureSchemeToFlag[scheme.Secp256k1] {
+			pass, err = secp256k1.NewSecp256k1PublicKey(pubKey).Verify(digest, sig)
+		} else {
+			pass, err = secp256r1.NewSecp256r1PublicKey(pubKey).Verify(digest, sig)
+		}
+		if err != nil || !pass {
+			return nil, ErrInvalidUserSignature
+		}
+		return append([]byte{userSignature[0]}, pubKey...), nil
+	default:
+		return nil, ErrUnsupportedUserScheme
+	}
+}
@@ -0,0 +1,282 @@
+package zklogin
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+	"github.com/block-vision/sui-go-sdk/cryptography/scheme"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+)
+
+const (
+	testIssBase64 = "yJpc3MiOiJodHRwczovL2FjY291bnRzLmdvb2dsZS5jb20iLC"
+	testIss       = "https://accounts.google.com"
+	testKid       = "test-kid"
+	testSeed      = "2455937816256448139867034291880826393632839155025573768185017581009163315316"
+)
+
+func TestProdVerifyingKey(t *testing.T) {
+	vk, err := ProdVerifyingKey()
+	require.NoError(t, err)
+	require.Len(t, vk.IC, 2)
+
+	_, err = VerifyingKeyForNetwork("devnet")
+	require.Error(t, err)
+}
+
+// testSetup builds a verifying key from known scalars and a proof that satisfies it for the
+// given public input, so the whole verification path can run without the real circuit.
+type testSetup struct {
+	vk    *VerifyingKey
+	jwks  JwkSet
+	sig   *ZkLoginSignature
+	msg   []byte
+	proof func(input *big.Int) ProofPoints
+}
+
+func newTestSetup(t testing.TB) *testSetup {
+	_, _, g1, g2 := bn254.Generators()
+	scalar := func(v int64) *big.Int { return big.NewInt(v) }
+	g1Mul := func(s *big.Int) bn254.G1Affine {
+		var p bn254.G1Affine
+		p.ScalarMultiplication(&g1, s)
+		return p
+	}
+	g2Mul := func(s *big.Int) bn254.G2Affine {
+		var p bn254.G2Affine
+		p.ScalarMultiplication(&g2, s)
+		return p
+	}
+
+	alpha, beta, gamma, delta := scalar(3), scalar(5), scalar(7), scalar(11)
+	ic0, ic1 := scalar(13), scalar(17)
+	a, b := scalar(19), scalar(23)
+	vk := &VerifyingKey{
+		Alpha: g1Mul(alpha),
+		Beta:  g2Mul(beta),
+		Gamma: g2Mul(gamma),
+		Delta: g2Mul(delta),
+		IC:    []bn254.G1Affine{g1Mul(ic0), g1Mul(ic1)},
+	}
+
+	// c = (a*b - alpha*beta - (ic0 + ic1*input)*gamma) / delta
+	proof := func(input *big.Int) ProofPoints {
+		r := fr.Modulus()
+		vkX := new(big.Int).Add(ic0, new(big.Int).Mul(ic1, input))
+		c := new(big.Int).Mul(a, b)
+		c.Sub(c, new(big.Int).Mul(alpha, beta))
+		c.Sub(c, vkX.Mul(vkX, gamma))
+		c.Mul(c, new(big.Int).ModInverse(delta, r))
+		c.Mod(c, r)
+
+		pa, pb, pc := g1Mul(a), g2Mul(b), g1Mul(c)
+		return ProofPoints{
+			A: []string{fpString(pa.X.BigInt), fpString(pa.Y.BigInt), "1"},
+			B: [][]string{
+				{fpString(pb.X.A0.BigInt), fpString(pb.X.A1.BigInt)},
+				{fpString(pb.Y.A0.BigInt), fpString(pb.Y.A1.BigInt)},
+				{"1", "0"},
+			},
+			C: []string{fpString(pc.X.BigInt), fpString(pc.Y.BigInt), "1"},
+		}
+	}
+
+	modulus := make([]byte, 256)
+	for i := range modulus {
+		modulus[i] = byte(i + 1)
+	}
+	jwks := JwkSet{
+		{Iss: testIss, Kid: testKid}: {
+			Kty: "RSA",
+			E:   "AQAB",
+			N:   base64.RawURLEncoding.EncodeToString(modulus),
+			Alg: "RS256",
+		},
+	}
+
+	pubKey, priKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	msg := []byte("zklogin intent message")
+	digest := blake2b.Sum256(msg)
+	userSig := append([]byte{0x00}, ed25519.Sign(priKey, digest[:])...)
+	userSig = append(userSig, pubKey...)
+
+	sig := &ZkLoginSignature{
+		Inputs: ZkLoginSignatureInputs{
+			IssBase64Details: IssBase64Details{Value: testIssBase64, IndexMod4: 1},
+			HeaderBase64:     base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"` + testKid + `","typ":"JWT"}`)),
+			AddressSeed:      testSeed,
+		},
+		MaxEpoch:      10,
+		UserSignature: userSig,
+	}
+	input, err := sig.Inputs.CalculateAllInputsHash(append([]byte{0x00}, pubKey...), modulus, sig.MaxEpoch)
+	require.NoError(t, err)
+	sig.Inputs.ProofPoints = proof(input)
+
+	return &testSetup{vk: vk, jwks: jwks, sig: sig, msg: msg, proof: proof}
+}
+
+func fpString(toBigInt func(*big.Int) *big.Int) string {
+	return toBigInt(new(big.Int)).String()
+}
+
+func TestVerifyZkLoginSignature(t *testing.T) {
+	setup := newTestSetup(t)
+	seed, _ := new(big.Int).SetString(testSeed, 10)
+	wantAddress := toZkLoginPublicIdentifier(seed, testIss, nil).ToSuiAddress()
+	delta := uint64(5)
+
+	tests := []struct {
+		name    string
+		modify  func(sig *ZkLoginSignature, params *VerifyParams, msg *[]byte)
+		wantErr error
+	}{
+		{
+			name:   "valid signature",
+			modify: func(*ZkLoginSignature, *VerifyParams, *[]byte) {},
+		},
+		{
+			name:    "max epoch expired",
+			modify:  func(_ *ZkLoginSignature, params *VerifyParams, _ *[]byte) { params.CurrentEpoch = 11 },
+			wantErr: ErrMaxEpochExpired,
+		},
+		{
+			name:    "max epoch too large",
+			modify:  func(_ *ZkLoginSignature, params *VerifyParams, _ *[]byte) { params.MaxEpochUpperBoundDelta = &delta },
+			wantErr: ErrMaxEpochTooLarge,
+		},
+		{
+			name:    "tampered message",
+			modify:  func(_ *ZkLoginSignature, _ *VerifyParams, msg *[]byte) { *msg = []byte("another message") },
+			wantErr: ErrInvalidUserSignature,
+		},
+		{
+			name:   "legacy google issuer",
+			modify: func(sig *ZkLoginSignature, _ *VerifyParams, _ *[]byte) { sig.Iss = "accounts.google.com" },
+		},
+		{
+			name: "jwk of the legacy google issuer",
+			modify: func(_ *ZkLoginSignature, params *VerifyParams, _ *[]byte) {
+				store := NewJwkStore()
+				store.Add(JwkId{Iss: "accounts.google.com", Kid: testKid}, setup.jwks[JwkId{Iss: testIss, Kid: testKid}])
+				params.Jwks = store.JwkSet()
+			},
+		},
+		{
+			name:    "unknown jwk",
+			modify:  func(_ *ZkLoginSignature, params *VerifyParams, _ *[]byte) { params.Jwks = JwkSet{} },
+			wantErr: ErrJwkNotFound,
+		},
+		{
+			name: "proof for another input",
+			modify: func(sig *ZkLoginSignature, _ *VerifyParams, _ *[]byte) {
+				sig.Inputs.ProofPoints = setup.proof(big.NewInt(1))
+			},
+			wantErr: ErrInvalidProof,
+		},
+		{
+			name: "different max epoch",
+			modify: func(sig *ZkLoginSignature, _ *VerifyParams, _ *[]byte) {
+				sig.MaxEpoch = 12
+			},
+			wantErr: ErrInvalidProof,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig := *setup.sig
+			msg := setup.msg
+			params := &VerifyParams{Jwks: setup.jwks, CurrentEpoch: 1, VerifyingKey: setup.vk}
+			tt.modify(&sig, params, &msg)
+
+			address, err := VerifyZkLoginSignature(msg, &sig, params)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, wantAddress, address)
+		})
+	}
+
+	// the address seed is checked before the ephemeral signature over the message
+	sig := *setup.sig
+	sig.Inputs.AddressSeed = "not a number"
+	_, err := VerifyZkLoginSignature([]byte("another message"), &sig, &VerifyParams{Jwks: setup.jwks, CurrentEpoch: 1, VerifyingKey: setup.vk})
+	require.ErrorContains(t, err, "invalid address seed")
+}
+
+// ed25519TestSigner is a minimal custom models.Signer holding an ephemeral ed25519 key.
+type ed25519TestSigner struct {
+	priKey ed25519.PrivateKey
+}
+
+func (s *ed25519TestSigner) GetKeyScheme() scheme.SignatureScheme { return scheme.ED25519 }
+func (s *ed25519TestSigner) GetPublicKey() []byte {
+	return s.priKey.Public().(ed25519.PublicKey)
+}
+func (s *ed25519TestSigner) ToSuiAddress() string { return "" }
+func (s *ed25519TestSigner) Sign(_ context.Context, digest []byte) ([]byte, error) {
+	return ed25519.Sign(s.priKey, digest), nil
+}
+
+func TestZkLoginSigner(t *testing.T) {
+	setup := newTestSetup(t)
+	_, priKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	ephemeral := &ed25519TestSigner{priKey: priKey}
+
+	inputs := setup.sig.Inputs
+	modulus, err := setup.jwks[JwkId{Iss: testIss, Kid: testKid}].Modulus()
+	require.NoError(t, err)
+	input, err := inputs.CalculateAllInputsHash(append([]byte{0x00}, ephemeral.GetPublicKey()...), modulus, 10)
+	require.NoError(t, err)
+	inputs.ProofPoints = setup.proof(input)
+
+	zkSigner, err := NewZkLoginSigner(ephemeral, inputs, 10)
+	require.NoError(t, err)
+
+	signature, err := models.SignWithIntent(context.Background(), zkSigner, setup.msg, constant.PersonalMessageIntentScope)
+	require.NoError(t, err)
+	parsed, err := ParseSerializedZkLoginSignature(signature)
+	require.NoError(t, err)
+	require.Equal(t, zkSigner.GetPublicKey(), parsed.PubKey)
+	serialized, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	_, err = ParseSerializedZkLoginSignature(append(serialized, 0))
+	require.ErrorContains(t, err, mystenbcs.ErrTrailingBytes.Error())
+
+	params := &VerifyParams{Jwks: setup.jwks, CurrentEpoch: 1, VerifyingKey: setup.vk}
+	address, err := VerifyZkLoginSignature(models.NewMessageWithIntent(setup.msg, constant.PersonalMessageIntentScope), parsed.ZkLogin, params)
+	require.NoError(t, err)
+	require.Equal(t, zkSigner.ToSuiAddress(), address)
+}
+
+// FuzzParseSerializedZkLoginSignature parses untrusted zkLogin signatures and their inputs.
+func FuzzParseSerializedZkLoginSignature(f *testing.F) {
+	setup := newTestSetup(f)
+	data, err := mystenbcs.Marshal(setup.sig)
+	require.NoError(f, err)
+	signature := append([]byte{scheme.SignatureSchemeToFlag[scheme.ZkLogin]}, data...)
+	f.Add(signature)
+	f.Add(signature[:len(signature)/2])
+
+	f.Fuzz(func(t *testing.T, signature []byte) {
+		parsed, err := ParseSerializedZkLoginSignature(signature)
+		if err != nil {
+			return
+		}
+		_, _ = VerifyZkLoginSignature(setup.msg, parsed.ZkLogin, &VerifyParams{VerifyingKey: setup.vk, Jwks: setup.jwks, CurrentEpoch: 1})
+	})
+}
@@ -0,0 +1,89 @@
+package zklogin
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/block-vision/sui-go-sdk/constant"
+)
+
+// The production zkLogin verifying key from the Sui ceremony, see
+// https://github.com/MystenLabs/fastcrypto/blob/main/fastcrypto-zkp/src/bn254/zk_login_api.rs
+// It is used by both mainnet and testnet; devnet and localnet circuits must be loaded with ParseVerifyingKey.
+var (
+	prodVkAlpha = []string{
+		"20491192805390485299153009773594534940189261866228447918068658471970481763042",
+		"9383485363053290200918347156157836566562967994039712273449902621266178545958",
+		"1",
+	}
+	prodVkBeta = [][]string{
+		{
+			"6375614351688725206403948262868962793625744043794305715222011528459656738731",
+			"4252822878758300859123897981450591353533073413197771768651442665752259397132",
+		},
+		{
+			"10505242626370262277552901082094356697409835680220590971873171140371331206856",
+			"21847035105528745403288232691147584728191162732299865338377159692350059136679",
+		},
+		{"1", "0"},
+	}
+	prodVkGamma = [][]string{
+		{
+			"10857046999023057135944570762232829481370756359578518086990519993285655852781",
+			"11559732032986387107991004021392285783925812861821192530917403151452391805634",
+		},
+		{
+			"8495653923123431417604973247489272438418190587263600148770280649306958101930",
+			"4082367875863433681332203403145435568316851327593401208105741076214120093531",
+		},
+		{"1", "0"},
+	}
+	prodVkDelta = [][]string{
+		{
+			"19260309516619721648285279557078789954438346514188902804737557357941293711874",
+			"2480422554560175324649200374556411861037961022026590718777465211464278308900",
+		},
+		{
+			"14489104692423540990601374549557603533921811847080812036788172274404299703364",
+			"12564378633583954025611992187142343628816140907276948128970903673042690269191",
+		},
+		{"1", "0"},
+	}
+	prodVkIC = [][]string{
+		{
+			"1607694606386445293170795095076356565829000940041894770459712091642365695804",
+			"18066827569413962196795937356879694709963206118612267170825707780758040578649",
+			"1",
+		},
+		{
+			"20653794344898475822834426774542692225449366952113790098812854265588083247207",
+			"3296759704176575765409730962060698204792513807296274014163938591826372646699",
+			"1",
+		},
+	}
+)
+
+var (
+	prodVkOnce sync.Once
+	prodVk     *VerifyingKey
+	prodVkErr  error
+)
+
+// ProdVerifyingKey returns the embedded production verifying key.
+func ProdVerifyingKey() (*VerifyingKey, error) {
+	prodVkOnce.Do(func() {
+		prodVk, prodVkErr = newVerifyingKey(prodVkAlpha, prodVkBeta, prodVkGamma, prodVkDelta, prodVkIC)
+	})
+
+	return prodVk, prodVkErr
+}
+
+// VerifyingKeyForNetwork returns the embedded verifying key used by the given network.
+func VerifyingKeyForNetwork(network string) (*VerifyingKey, error) {
+	switch network {
+	case constant.SuiMainnet, constant.SuiTestnet:
+		return ProdVerifyingKey()
+	default:
+		return nil, fmt.Errorf("no embedded zklogin verifying key for network %s, use ParseVerifyingKey", network)
+	}
+}